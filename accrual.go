@@ -0,0 +1,97 @@
+package inf
+
+import (
+	"time"
+)
+
+// DayCount represents a day count convention, which determines the fraction
+// of a year between two dates for the purpose of accruing interest.
+type DayCount int
+
+// Day count conventions.
+const (
+	// Act360 divides the actual number of days by 360.
+	Act360 DayCount = iota
+	// Act365Fixed divides the actual number of days by 365.
+	Act365Fixed
+	// Thirty360 (30/360 US, bond basis) counts each month as 30 days: a
+	// start day of 31 is changed to 30, and an end day of 31 is changed to
+	// 30 when the (adjusted) start day is 30.
+	Thirty360
+	// Thirty360E (30E/360, Eurobond basis) counts each month as 30 days: a
+	// start or end day of 31 is changed to 30.
+	Thirty360E
+)
+
+var dayCountNames = [...]string{"ACT/360", "ACT/365F", "30/360", "30E/360"}
+
+func (dc DayCount) String() string {
+	if int(dc) < len(dayCountNames) {
+		return dayCountNames[dc]
+	}
+	return "DayCount(?)"
+}
+
+// Basis returns the number of days in a year for dc.
+func (dc DayCount) Basis() int {
+	if dc == Act365Fixed {
+		return 365
+	}
+	return 360
+}
+
+// Days returns the number of days from start to end according to dc, which
+// is negative if end is before start. Only the dates (year, month and day in
+// the locations of start and end) are taken into account.
+func (dc DayCount) Days(start, end time.Time) int {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	switch dc {
+	case Thirty360:
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 && d1 == 30 {
+			d2 = 30
+		}
+	case Thirty360E:
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 {
+			d2 = 30
+		}
+	default:
+		t1 := time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC)
+		t2 := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC)
+		return int(t2.Sub(t1) / (24 * time.Hour))
+	}
+	return 360*(y2-y1) + 30*int(m2-m1) + (d2 - d1)
+}
+
+// YearFraction returns the fraction of a year from start to end according to
+// dc (that is, Days(start, end) / Basis()), rounded using the given Rounder
+// to the specified scale.
+//
+// If the rounder is RoundExact but the result can not be expressed exactly at
+// the specified scale, YearFraction returns nil.
+func (dc DayCount) YearFraction(start, end time.Time, s Scale, r Rounder) *Dec {
+	days := NewDec(int64(dc.Days(start, end)), 0)
+	return new(Dec).QuoRound(days, NewDec(int64(dc.Basis()), 0), s, r)
+}
+
+// Accrue returns the interest accrued on principal at the annual rate from
+// start to end according to dc; that is,
+//
+//	principal * rate * Days(start, end) / Basis()
+//
+// calculated exactly and rounded only once, using the given Rounder to the
+// specified scale.
+//
+// If the rounder is RoundExact but the result can not be expressed exactly at
+// the specified scale, Accrue returns nil.
+func (dc DayCount) Accrue(principal, rate *Dec, start, end time.Time, s Scale, r Rounder) *Dec {
+	days := NewDec(int64(dc.Days(start, end)), 0)
+	pr := new(Dec).Mul(principal, rate)
+	return new(Dec).MulQuo(pr, days, NewDec(int64(dc.Basis()), 0), s, r)
+}
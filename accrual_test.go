@@ -0,0 +1,60 @@
+package inf_test
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/inf.v0"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+var decDayCountTests = []struct {
+	dc         inf.DayCount
+	start, end time.Time
+	days       int
+	frac       string // at scale 8, RoundHalfEven
+	interest   string // on 1000000.00 at 5.25%, scale 2, RoundHalfEven
+}{
+	{inf.Act360, date(2024, 1, 15), date(2024, 7, 15), 182, "0.50555556", "26541.67"},
+	{inf.Act365Fixed, date(2024, 1, 15), date(2024, 7, 15), 182, "0.49863014", "26178.08"},
+	{inf.Thirty360, date(2024, 1, 15), date(2024, 7, 15), 180, "0.50000000", "26250.00"},
+	{inf.Thirty360E, date(2024, 1, 15), date(2024, 7, 15), 180, "0.50000000", "26250.00"},
+	{inf.Act360, date(2024, 3, 31), date(2024, 3, 1), -30, "-0.08333333", "-4375.00"},
+	{inf.Thirty360, date(2024, 1, 31), date(2024, 3, 31), 60, "0.16666667", "8750.00"},
+	{inf.Thirty360E, date(2024, 1, 31), date(2024, 3, 31), 60, "0.16666667", "8750.00"},
+	{inf.Thirty360, date(2024, 1, 30), date(2024, 3, 31), 60, "0.16666667", "8750.00"},
+	{inf.Thirty360, date(2024, 1, 29), date(2024, 3, 31), 62, "0.17222222", "9041.67"},
+	{inf.Thirty360E, date(2024, 1, 29), date(2024, 3, 31), 61, "0.16944444", "8895.83"},
+	{inf.Act365Fixed, date(2023, 12, 31), date(2024, 12, 31), 366, "1.00273973", "52643.84"},
+}
+
+func TestDecDayCount(t *testing.T) {
+	principal, rate := inf.NewDec(100000000, 2), inf.NewDec(525, 4)
+	for i, tt := range decDayCountTests {
+		if d := tt.dc.Days(tt.start, tt.end); d != tt.days {
+			t.Errorf("#%d %v Days got %d; expected %d", i, tt.dc, d, tt.days)
+		}
+		if f := tt.dc.YearFraction(tt.start, tt.end, 8, inf.RoundHalfEven); f.String() != tt.frac {
+			t.Errorf("#%d %v YearFraction got %v; expected %s", i, tt.dc, f, tt.frac)
+		}
+		a := tt.dc.Accrue(principal, rate, tt.start, tt.end, 2, inf.RoundHalfEven)
+		if a.String() != tt.interest {
+			t.Errorf("#%d %v Accrue got %v; expected %s", i, tt.dc, a, tt.interest)
+		}
+	}
+}
+
+func TestDecDayCountDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	start := time.Date(2024, 3, 9, 12, 0, 0, 0, loc)
+	end := time.Date(2024, 3, 11, 0, 30, 0, 0, loc)
+	if d := inf.Act360.Days(start, end); d != 2 {
+		t.Errorf("Days across DST change got %d; expected 2", d)
+	}
+}
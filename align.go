@@ -0,0 +1,87 @@
+package inf
+
+// AlignScales returns the greatest of the scales of the values in xs, which
+// is the scale that all of them can be represented with exactly (as used by
+// Add and Sub), or 0 if xs is empty.
+func AlignScales(xs ...*Dec) Scale {
+	var s Scale
+	for i, x := range xs {
+		if i == 0 || x.Scale() > s {
+			s = x.Scale()
+		}
+	}
+	return s
+}
+
+// UpscaleTo sets buf to the value of x represented with scale s, and returns
+// buf. If buf is nil, a new Dec is allocated. s must not be less than the
+// scale of x (as the value could change otherwise); UpscaleTo returns nil in
+// that case.
+//
+// UpscaleTo allows aligning operands to a common scale (see AlignScales) once,
+// into reusable storage, when they are used in many operations; operations on
+// values with equal scales do not need to rescale their operands.
+func UpscaleTo(x *Dec, s Scale, buf *Dec) *Dec {
+	if s < x.Scale() {
+		return nil
+	}
+	if buf == nil {
+		buf = new(Dec)
+	}
+	if s == x.Scale() {
+		return buf.Set(x)
+	}
+	buf.UnscaledBig().Mul(x.UnscaledBig(), exp10(checkScale(int64(s)-int64(x.Scale()))))
+	return buf.SetScale(s)
+}
+
+// NonNegScale sets z to the value of x with a non-negative scale, and returns
+// z. If the scale of x is negative, it is materialized into the unscaled
+// value, so that the scale of z is 0 (as in 1E+3, with unscaled value 1 and
+// scale -3, becoming 1000 with scale 0); otherwise z is set to x.
+//
+// Negative scales are valid for all operations, but may be unexpected by
+// code that formats or encodes values; NonNegScale can be applied to results
+// that are passed to such code. To apply it to every result of a calculation,
+// use a Context with NonNegScale set.
+func (z *Dec) NonNegScale(x *Dec) *Dec {
+	if x.Scale() >= 0 {
+		return z.Set(x)
+	}
+	z.UnscaledBig().Mul(x.UnscaledBig(), exp10(checkScale(-int64(x.Scale()))))
+	return z.SetScale(0)
+}
+
+// movePoint sets z to x * 10**n by adjusting the scale only, and returns z.
+func (z *Dec) movePoint(x *Dec, n Scale) *Dec {
+	return z.Set(x).SetScale(checkScale(int64(x.Scale()) - int64(n)))
+}
+
+// MovePointLeft sets z to x / 10**n and returns z. Only the scale is
+// adjusted (increased by n), so the result is exact and the unscaled value is
+// unchanged.
+func (z *Dec) MovePointLeft(x *Dec, n Scale) *Dec {
+	return z.movePoint(x, -n)
+}
+
+// MovePointRight sets z to x * 10**n and returns z. Only the scale is
+// adjusted (decreased by n), so the result is exact and the unscaled value is
+// unchanged; the scale of z may become negative (see NonNegScale).
+func (z *Dec) MovePointRight(x *Dec, n Scale) *Dec {
+	return z.movePoint(x, n)
+}
+
+// TrySetScale changes the scale of z to s, adjusting the unscaled value so
+// that the value of z is preserved, and reports whether it did so. If the
+// value of z can not be represented with the scale s without rounding (see
+// FitsScale), z is left unchanged and TrySetScale returns false.
+//
+// Unlike SetScale, which changes the value of z, TrySetScale only changes its
+// representation.
+func (z *Dec) TrySetScale(s Scale) bool {
+	if !z.FitsScale(s) {
+		return false
+	}
+	z.Set(z.rescale(s))
+	return true
+}
@@ -0,0 +1,121 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecAlignScales(t *testing.T) {
+	for i, tt := range []struct {
+		xs []*inf.Dec
+		s  inf.Scale
+	}{
+		{nil, 0},
+		{[]*inf.Dec{inf.NewDec(1, -2)}, -2},
+		{[]*inf.Dec{inf.NewDec(1, -2), inf.NewDec(1, -5)}, -2},
+		{[]*inf.Dec{inf.NewDec(1, 0), inf.NewDec(15, 1), inf.NewDec(125, 3)}, 3},
+	} {
+		if s := inf.AlignScales(tt.xs...); s != tt.s {
+			t.Errorf("#%d AlignScales%v got %d; expected %d", i, tt.xs, s, tt.s)
+		}
+	}
+}
+
+func TestDecUpscaleTo(t *testing.T) {
+	buf := new(inf.Dec)
+	for i, tt := range []struct {
+		x   *inf.Dec
+		s   inf.Scale
+		exp string // empty if nil result expected
+	}{
+		{inf.NewDec(15, 1), 1, "1.5"},
+		{inf.NewDec(15, 1), 4, "1.5000"},
+		{inf.NewDec(-15, 1), 3, "-1.500"},
+		{inf.NewDec(1, -2), 0, "100"},
+		{inf.NewDec(15, 1), 0, ""},
+	} {
+		z := inf.UpscaleTo(tt.x, tt.s, buf)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d UpscaleTo(%v, %d) got %v; expected nil", i, tt.x, tt.s, z)
+			}
+			continue
+		}
+		if z != buf || z.String() != tt.exp || z.Scale() != tt.s {
+			t.Errorf("#%d UpscaleTo(%v, %d) got %v; expected %s in buf", i, tt.x, tt.s, z, tt.exp)
+		}
+	}
+	if z := inf.UpscaleTo(inf.NewDec(1, 0), 2, nil); z == nil || z.String() != "1.00" {
+		t.Errorf("UpscaleTo with nil buf got %v; expected 1.00", z)
+	}
+}
+
+func TestDecNonNegScale(t *testing.T) {
+	for i, tt := range []struct {
+		x   *inf.Dec
+		exp *inf.Dec
+	}{
+		{inf.NewDec(1, -3), inf.NewDec(1000, 0)},
+		{inf.NewDec(-12, -2), inf.NewDec(-1200, 0)},
+		{inf.NewDec(0, -2), inf.NewDec(0, 0)},
+		{inf.NewDec(15, 1), inf.NewDec(15, 1)},
+		{inf.NewDec(15, 0), inf.NewDec(15, 0)},
+	} {
+		x := new(inf.Dec).Set(tt.x)
+		z := new(inf.Dec).NonNegScale(x)
+		if z.Cmp(tt.exp) != 0 || z.Scale() != tt.exp.Scale() {
+			t.Errorf("#%d NonNegScale(%v) got %v (scale %d); expected %v (scale %d)",
+				i, tt.x, z, z.Scale(), tt.exp, tt.exp.Scale())
+		}
+		if x.NonNegScale(x); x.Cmp(tt.exp) != 0 || x.Scale() != tt.exp.Scale() {
+			t.Errorf("#%d NonNegScale in place got %v (scale %d)", i, x, x.Scale())
+		}
+	}
+}
+
+func TestDecMovePoint(t *testing.T) {
+	for i, tt := range []struct {
+		x           string
+		n           inf.Scale
+		left, right string
+	}{
+		{"123.45", 2, "1.2345", "12345"},
+		{"123.45", 0, "123.45", "123.45"},
+		{"-5", 3, "-0.005", "-5000"},
+		{"0.01", -1, "0.1", "0.001"},
+	} {
+		x := decs(tt.x)[0]
+		if z := new(inf.Dec).MovePointLeft(x, tt.n); z.String() != tt.left || z.UnscaledBig().Cmp(x.UnscaledBig()) != 0 {
+			t.Errorf("#%d MovePointLeft(%v, %d) got %v; expected %s", i, x, tt.n, z, tt.left)
+		}
+		if z := new(inf.Dec).MovePointRight(x, tt.n); z.String() != tt.right || z.UnscaledBig().Cmp(x.UnscaledBig()) != 0 {
+			t.Errorf("#%d MovePointRight(%v, %d) got %v; expected %s", i, x, tt.n, z, tt.right)
+		}
+	}
+}
+
+func TestDecTrySetScale(t *testing.T) {
+	for i, tt := range []struct {
+		x   string
+		s   inf.Scale
+		ok  bool
+		exp string
+	}{
+		{"1.20", 3, true, "1.200"},
+		{"1.20", 1, true, "1.2"},
+		{"1.20", 0, false, "1.20"},
+		{"1.25", 1, false, "1.25"},
+		{"1200", -2, true, "1200"},
+		{"1250", -2, false, "1250"},
+		{"0.00", -5, true, "0"},
+	} {
+		z := decs(tt.x)[0]
+		if ok := z.TrySetScale(tt.s); ok != tt.ok || z.String() != tt.exp {
+			t.Errorf("#%d %s.TrySetScale(%d) got %v, %v; expected %v, %s", i, tt.x, tt.s, ok, z, tt.ok, tt.exp)
+		}
+		if tt.ok && z.Scale() != tt.s {
+			t.Errorf("#%d %s.TrySetScale(%d): scale %d", i, tt.x, tt.s, z.Scale())
+		}
+	}
+}
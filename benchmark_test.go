@@ -152,6 +152,27 @@ func Benchmark_Dec_QuoRound_Fixed_HalfUp(b *testing.B) {
 	})
 }
 
+func Benchmark_Dec_QuoRound_Fixed_HalfEven(b *testing.B) {
+	b.ReportAllocs()
+	doBenchmarkDec2(b, func(x, y *Dec) {
+		_ = new(Dec).QuoRound(x, y, 0, RoundHalfEven)
+	})
+}
+
+func Benchmark_Dec_QuoRound_Fixed_Floor(b *testing.B) {
+	b.ReportAllocs()
+	doBenchmarkDec2(b, func(x, y *Dec) {
+		_ = new(Dec).QuoRound(x, y, 0, RoundFloor)
+	})
+}
+
+func Benchmark_Dec_Round_HalfEven(b *testing.B) {
+	b.ReportAllocs()
+	doBenchmarkDec1(b, func(x *Dec) {
+		_ = new(Dec).Round(x, x.Scale()-3, RoundHalfEven)
+	})
+}
+
 func Benchmark_Int_String(b *testing.B) {
 	doBenchmarkInt1(b, func(x *big.Int) {
 		x.String()
@@ -0,0 +1,68 @@
+package inf
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// twosComplement returns the minimal big-endian two's complement
+// representation of u (at least one byte), as java.math.BigInteger's
+// toByteArray.
+func twosComplement(u *big.Int) []byte {
+	if u.Sign() >= 0 {
+		b := u.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	}
+	// -u-1 == ^u for the bits of the magnitude
+	t := new(big.Int).Neg(u)
+	b := t.Sub(t, bigInt[1]).Bytes()
+	for i := range b {
+		b[i] = ^b[i]
+	}
+	if len(b) == 0 || b[0]&0x80 == 0 {
+		b = append([]byte{0xff}, b...)
+	}
+	return b
+}
+
+// setTwosComplement sets z to the value of the big-endian two's complement
+// representation in b, and returns z. An empty b represents 0.
+func setTwosComplement(z *big.Int, b []byte) *big.Int {
+	if len(b) == 0 || b[0]&0x80 == 0 {
+		return z.SetBytes(b)
+	}
+	t := make([]byte, len(b))
+	for i := range b {
+		t[i] = ^b[i]
+	}
+	z.SetBytes(t)
+	z.Add(z, bigInt[1])
+	return z.Neg(z)
+}
+
+// BigDecimalBytes returns the representation of x used for exchanging Java
+// java.math.BigDecimal values: the unscaled value as the minimal big-endian
+// two's complement byte sequence (as returned by BigInteger.toByteArray),
+// and the scale (as returned by BigDecimal.scale).
+func (x *Dec) BigDecimalBytes() (unscaled []byte, scale int32) {
+	return twosComplement(x.UnscaledBig()), int32(x.Scale())
+}
+
+// SetBigDecimalBytes sets z to the value represented by the unscaled value as
+// a big-endian two's complement byte sequence and the scale, in the format
+// returned by BigDecimalBytes, and returns z. Non-minimal byte sequences
+// (with redundant sign bytes) are accepted.
+//
+// SetBigDecimalBytes returns an error if unscaled is empty, as Java's
+// BigInteger(byte[]) constructor does; the value of z is undefined in that
+// case.
+func (z *Dec) SetBigDecimalBytes(unscaled []byte, scale int32) (*Dec, error) {
+	if len(unscaled) == 0 {
+		return nil, fmt.Errorf("Dec.SetBigDecimalBytes: no data")
+	}
+	setTwosComplement(z.UnscaledBig(), unscaled)
+	return z.SetScale(Scale(scale)), nil
+}
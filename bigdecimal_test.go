@@ -0,0 +1,51 @@
+package inf_test
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+// expected values as produced by new BigDecimal(s).unscaledValue().toByteArray()
+var decBigDecimalBytesTests = []struct {
+	in       string
+	unscaled []byte
+	scale    int32
+}{
+	{"0", []byte{0x00}, 0},
+	{"0.00", []byte{0x00}, 2},
+	{"1", []byte{0x01}, 0},
+	{"-1", []byte{0xff}, 0},
+	{"1.27", []byte{0x7f}, 2},
+	{"1.28", []byte{0x00, 0x80}, 2},
+	{"-1.28", []byte{0x80}, 2},
+	{"-1.29", []byte{0xff, 0x7f}, 2},
+	{"2.55", []byte{0x00, 0xff}, 2},
+	{"-2.56", []byte{0xff, 0x00}, 2},
+	{"123456.789", []byte{0x07, 0x5b, 0xcd, 0x15}, 3},
+	{"-123456.789", []byte{0xf8, 0xa4, 0x32, 0xeb}, 3},
+	{"18446744073709551616", []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+	{"-9223372036854775808", []byte{0x80, 0, 0, 0, 0, 0, 0, 0}, 0},
+}
+
+func TestDecBigDecimalBytes(t *testing.T) {
+	for i, tt := range decBigDecimalBytesTests {
+		x, _ := new(inf.Dec).SetString(tt.in)
+		b, s := x.BigDecimalBytes()
+		if !bytes.Equal(b, tt.unscaled) || s != tt.scale {
+			t.Errorf("#%d BigDecimalBytes of %v got %x, %d; expected %x, %d", i, x, b, s, tt.unscaled, tt.scale)
+		}
+		z, err := new(inf.Dec).SetBigDecimalBytes(tt.unscaled, tt.scale)
+		if err != nil || z.Cmp(x) != 0 || z.Scale() != x.Scale() {
+			t.Errorf("#%d SetBigDecimalBytes(%x, %d) got %v, %v; expected %v", i, tt.unscaled, tt.scale, z, err, x)
+		}
+	}
+	// redundant sign bytes
+	if z, err := new(inf.Dec).SetBigDecimalBytes([]byte{0xff, 0xff, 0x80}, 1); err != nil || z.String() != "-12.8" {
+		t.Errorf("SetBigDecimalBytes with sign extension got %v, %v; expected -12.8", z, err)
+	}
+	if _, err := new(inf.Dec).SetBigDecimalBytes(nil, 0); err == nil {
+		t.Errorf("SetBigDecimalBytes with no data got no error")
+	}
+}
@@ -0,0 +1,84 @@
+package inf
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Canonical sets z to the canonical representation of the value of x, and
+// returns z. The canonical representation has no trailing zeros in the
+// fraction part, and a non-negative scale; that is, its scale is the number
+// of significant fraction digits (such as 1.2 with scale 1 for 1.20, and 1200
+// with scale 0 for 1.2E+3). Zero is represented with scale 0 (and, as all
+// Dec values, has no sign).
+//
+// Values that are equal (as by Cmp) have the same canonical representation,
+// so that they are formatted and encoded identically. See CanonicalDec.
+func (z *Dec) Canonical(x *Dec) *Dec {
+	u, s := x.reduced()
+	if s < 0 {
+		u, s = new(big.Int).Mul(u, exp10(-s)), 0
+	}
+	z.UnscaledBig().Set(u)
+	return z.SetScale(s)
+}
+
+// Reduce sets z to the value of x with all trailing zeros removed from the
+// unscaled value, lowering the scale accordingly, and returns z. This is the
+// representation of x with the least scale, which is negative for integers
+// with trailing zeros (such as 12 with scale -2 for 1200); zero is represented
+// with scale 0. Unlike Canonical, Reduce minimizes the unscaled value rather
+// than keeping the scale non-negative. The scale of z is not lowered below
+// MinScale.
+func (z *Dec) Reduce(x *Dec) *Dec {
+	u, s := x.reduced()
+	z.UnscaledBig().Set(u)
+	return z.SetScale(s)
+}
+
+// IsCanonical reports whether x is in its canonical representation (see
+// Canonical).
+func (x *Dec) IsCanonical() bool {
+	if x.Sign() == 0 {
+		return x.Scale() == 0
+	}
+	if x.Scale() < 0 {
+		return false
+	}
+	_, s := x.reduced()
+	return s == x.Scale() || s < 0 && x.Scale() == 0
+}
+
+// A CanonicalDec is a Dec that is encoded in its canonical representation
+// (see Canonical) by MarshalText (and thus as JSON) and GobEncode. Values that
+// are equal (as by Cmp) are guaranteed to be encoded identically, regardless
+// of their scales; for example, both 1.20 and 1.2 are encoded as "1.2". The
+// decoding methods are those of Dec, so that any representation is accepted.
+//
+// A CanonicalDec can be used in place of a Dec in structures that are
+// serialized for comparison or storage.
+type CanonicalDec struct {
+	Dec
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (x *CanonicalDec) MarshalText() ([]byte, error) {
+	return new(Dec).Canonical(&x.Dec).MarshalText()
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (x *CanonicalDec) GobEncode() ([]byte, error) {
+	return new(Dec).Canonical(&x.Dec).GobEncode()
+}
+
+// String returns the string representation of the canonical representation
+// of x.
+func (x *CanonicalDec) String() string {
+	return new(Dec).Canonical(&x.Dec).String()
+}
+
+// Format is a support routine for fmt.Formatter. It formats the canonical
+// representation of x as by Dec.Format.
+func (x *CanonicalDec) Format(s fmt.State, ch rune) {
+	new(Dec).Canonical(&x.Dec).Format(s, ch)
+}
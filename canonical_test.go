@@ -0,0 +1,111 @@
+package inf_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecCanonical(t *testing.T) {
+	for i, tt := range []struct {
+		x     *inf.Dec
+		exp   *inf.Dec
+		canon bool
+	}{
+		{inf.NewDec(120, 2), inf.NewDec(12, 1), false},
+		{inf.NewDec(12, 1), inf.NewDec(12, 1), true},
+		{inf.NewDec(-12000, 3), inf.NewDec(-12, 0), false},
+		{inf.NewDec(12, -2), inf.NewDec(1200, 0), false},
+		{inf.NewDec(1200, 0), inf.NewDec(1200, 0), true},
+		{inf.NewDec(0, 3), inf.NewDec(0, 0), false},
+		{inf.NewDec(0, -3), inf.NewDec(0, 0), false},
+		{inf.NewDec(0, 0), inf.NewDec(0, 0), true},
+	} {
+		if c := tt.x.IsCanonical(); c != tt.canon {
+			t.Errorf("#%d IsCanonical(%v) got %v; expected %v", i, tt.x, c, tt.canon)
+		}
+		x := new(inf.Dec).Set(tt.x)
+		for _, z := range []*inf.Dec{new(inf.Dec).Canonical(x), x.Canonical(x)} {
+			if z.Cmp(tt.exp) != 0 || z.Scale() != tt.exp.Scale() || !z.IsCanonical() {
+				t.Errorf("#%d Canonical(%v) got %v (scale %d); expected %v (scale %d)",
+					i, tt.x, z, z.Scale(), tt.exp, tt.exp.Scale())
+			}
+		}
+	}
+}
+
+func TestDecReduce(t *testing.T) {
+	for i, tt := range []struct {
+		x   *inf.Dec
+		exp *inf.Dec
+	}{
+		{inf.NewDec(120, 2), inf.NewDec(12, 1)},
+		{inf.NewDec(-12000, 3), inf.NewDec(-12, 0)},
+		{inf.NewDec(1200, 0), inf.NewDec(12, -2)},
+		{inf.NewDec(12, -2), inf.NewDec(12, -2)},
+		{inf.NewDec(7, 3), inf.NewDec(7, 3)},
+		{inf.NewDec(0, 3), inf.NewDec(0, 0)},
+	} {
+		x := new(inf.Dec).Set(tt.x)
+		for _, z := range []*inf.Dec{new(inf.Dec).Reduce(x), x.Reduce(x)} {
+			if z.Cmp(tt.exp) != 0 || z.Scale() != tt.exp.Scale() {
+				t.Errorf("#%d Reduce(%v) got %v (scale %d); expected %v (scale %d)",
+					i, tt.x, z, z.Scale(), tt.exp, tt.exp.Scale())
+			}
+		}
+	}
+}
+
+type canonicalRecord struct {
+	Amount inf.CanonicalDec
+}
+
+func TestDecCanonicalDecEncoding(t *testing.T) {
+	var js, gobs [][]byte
+	for _, x := range []*inf.Dec{inf.NewDec(12, 1), inf.NewDec(120, 2), inf.NewDec(1200000, 6)} {
+		rec := canonicalRecord{inf.CanonicalDec{*x}}
+		b, err := json.Marshal(&rec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		js = append(js, b)
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(&rec); err != nil {
+			t.Fatal(err)
+		}
+		gobs = append(gobs, buf.Bytes())
+		var got canonicalRecord
+		if err := gob.NewDecoder(&buf).Decode(&got); err != nil || got.Amount.Cmp(x) != 0 {
+			t.Errorf("gob round trip of %v got %v, %v", x, &got.Amount.Dec, err)
+		}
+	}
+	for i := range js {
+		if string(js[i]) != `{"Amount":"1.2"}` {
+			t.Errorf("#%d JSON got %s; expected {\"Amount\":\"1.2\"}", i, js[i])
+		}
+		if !bytes.Equal(gobs[i], gobs[0]) {
+			t.Errorf("#%d gob encoding differs from #0", i)
+		}
+	}
+	var rec canonicalRecord
+	if err := json.Unmarshal([]byte(`{"Amount":"1.200"}`), &rec); err != nil ||
+		rec.Amount.Scale() != 3 || rec.Amount.String() != "1.2" {
+		t.Errorf("Unmarshal got %v (scale %d), %v", &rec.Amount, rec.Amount.Scale(), err)
+	}
+}
+
+func TestDecCanonicalDecFormat(t *testing.T) {
+	x := &inf.CanonicalDec{*inf.NewDec(120, 2)}
+	for _, format := range []string{"%v", "%s", "%d", "%f"} {
+		if s := fmt.Sprintf(format, x); s != "1.2" {
+			t.Errorf("Sprintf(%q, 1.20) got %s; expected 1.2", format, s)
+		}
+	}
+	if s := fmt.Sprint(x); s != x.String() {
+		t.Errorf("Sprint(1.20) got %s; expected %s", s, x.String())
+	}
+}
@@ -0,0 +1,40 @@
+package inf
+
+// PctChange returns the percentage change from the value from to the value
+// to, that is (to-from)/|from|*100, rounded using the given Rounder to the specified
+// scale. The difference is calculated exactly, and the result is rounded only
+// once.
+//
+// The change is relative to the absolute value of from, so that its sign is
+// the sign of the difference; for example, the change from -200 to -100 is
+// +50%.
+//
+// PctChange returns nil if from is zero (as the change from a zero baseline is
+// undefined), or if the rounder is RoundExact but the result can not be
+// expressed exactly at the specified scale.
+func PctChange(from, to *Dec, s Scale, r Rounder) *Dec {
+	if from.Sign() == 0 {
+		return nil
+	}
+	d := new(Dec).Sub(to, from)
+	return new(Dec).MulQuo(d, NewDec(100, 0), new(Dec).Abs(from), s, r)
+}
+
+// RelDiff returns the relative difference of x and y, that is
+// |x-y|/max(|x|,|y|), rounded using the given Rounder to the specified scale.
+// The result is in the range [0, 2]; it is 0 if x and y are equal (including
+// if both are zero).
+//
+// RelDiff returns nil if the rounder is RoundExact but the result can not be
+// expressed exactly at the specified scale.
+func RelDiff(x, y *Dec, s Scale, r Rounder) *Dec {
+	d := new(Dec).Sub(x, y)
+	if d.Sign() == 0 {
+		return new(Dec).Round(d, s, r)
+	}
+	ax, ay := new(Dec).Abs(x), new(Dec).Abs(y)
+	if ax.Cmp(ay) < 0 {
+		ax = ay
+	}
+	return new(Dec).QuoRound(d.Abs(d), ax, s, r)
+}
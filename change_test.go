@@ -0,0 +1,54 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecPctChange(t *testing.T) {
+	for i, tt := range []struct {
+		from, to string
+		exp      string // empty if nil is expected
+	}{
+		{"100", "150", "50.00"},
+		{"150", "100", "-33.33"},
+		{"-200", "-100", "50.00"},
+		{"-100", "100", "200.00"},
+		{"1.25", "1.25", "0.00"},
+		{"3", "4", "33.33"},
+		{"0", "1", ""},
+		{"0.00", "0", ""},
+	} {
+		xs := decs(tt.from, tt.to)
+		from, to := xs[0], xs[1]
+		z := inf.PctChange(from, to, 2, inf.RoundHalfEven)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d PctChange(%v, %v) got %v; expected nil", i, from, to, z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d PctChange(%v, %v) got %v; expected %s", i, from, to, z, tt.exp)
+		}
+	}
+}
+
+func TestDecRelDiff(t *testing.T) {
+	for i, tt := range []struct {
+		x, y string
+		exp  string
+	}{
+		{"100", "150", "0.3333"},
+		{"150", "100", "0.3333"},
+		{"-1", "1", "2.0000"},
+		{"0", "0.00", "0.0000"},
+		{"0", "5", "1.0000"},
+		{"2.5", "2.50", "0.0000"},
+	} {
+		xs := decs(tt.x, tt.y)
+		x, y := xs[0], xs[1]
+		if z := inf.RelDiff(x, y, 4, inf.RoundHalfEven); z == nil || z.String() != tt.exp {
+			t.Errorf("#%d RelDiff(%v, %v) got %v; expected %s", i, x, y, z, tt.exp)
+		}
+	}
+}
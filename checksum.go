@@ -0,0 +1,97 @@
+package inf
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// integer returns the value of x as a *big.Int and true when x is an integer;
+// otherwise it returns nil and false. The returned value must not be modified
+// when the scale of x is 0.
+func (x *Dec) integer() (*big.Int, bool) {
+	s := x.Scale()
+	switch {
+	case s == 0:
+		return x.UnscaledBig(), true
+	case s < 0:
+		return new(big.Int).Mul(x.UnscaledBig(), exp10(-s)), true
+	}
+	q, r := new(big.Int).QuoRem(x.UnscaledBig(), exp10(s), new(big.Int))
+	if r.Sign() != 0 {
+		return nil, false
+	}
+	return q, true
+}
+
+// checksumInt returns the value of x, or an error if x is not a non-negative
+// integer.
+func (x *Dec) checksumInt(op string) (*big.Int, error) {
+	i, ok := x.integer()
+	if !ok || i.Sign() < 0 {
+		return nil, fmt.Errorf("Dec.%s: %v is not a non-negative integer", op, x)
+	}
+	return i, nil
+}
+
+// luhnSum returns the Luhn sum of the digits in s, doubling every second
+// digit from the right, starting with the rightmost one if double is true.
+func luhnSum(s string, double bool) int {
+	sum := 0
+	for i := len(s) - 1; i >= 0; i-- {
+		d := int(s[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum
+}
+
+// LuhnCheckDigit returns the Luhn (mod 10) check digit to be appended to x.
+// It returns an error if x is not a non-negative integer. Leading zeros do not
+// affect the check digit.
+func (x *Dec) LuhnCheckDigit() (int, error) {
+	i, err := x.checksumInt("LuhnCheckDigit")
+	if err != nil {
+		return 0, err
+	}
+	return (10 - luhnSum(i.String(), true)%10) % 10, nil
+}
+
+// LuhnValid reports whether x is a non-negative integer with a valid Luhn
+// (mod 10) check digit as its last digit.
+func (x *Dec) LuhnValid() bool {
+	i, err := x.checksumInt("LuhnValid")
+	return err == nil && luhnSum(i.String(), false)%10 == 0
+}
+
+var big97 = big.NewInt(97)
+
+// Mod97 returns the remainder of x divided by 97, as used by ISO 7064
+// MOD 97-10 (e.g. for validating IBANs after rearranging and converting
+// letters to digits, in which case the remainder is 1 for valid input).
+// It returns an error if x is not a non-negative integer.
+func (x *Dec) Mod97() (int, error) {
+	i, err := x.checksumInt("Mod97")
+	if err != nil {
+		return 0, err
+	}
+	return int(new(big.Int).Mod(i, big97).Int64()), nil
+}
+
+// Mod97CheckDigits returns the two ISO 7064 MOD 97-10 check digits (in the
+// range 2 to 98) to be appended to x, such that the resulting number has a
+// remainder of 1 when divided by 97.
+// It returns an error if x is not a non-negative integer.
+func (x *Dec) Mod97CheckDigits() (int, error) {
+	i, err := x.checksumInt("Mod97CheckDigits")
+	if err != nil {
+		return 0, err
+	}
+	m := new(big.Int).Mul(i, exp10(2))
+	return 98 - int(m.Mod(m, big97).Int64()), nil
+}
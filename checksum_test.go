@@ -0,0 +1,59 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decChecksumTests = []struct {
+	in       string
+	luhn     int
+	mod97    int
+	mod97chk int
+	ok       bool
+}{
+	{"0", 0, 0, 98, true},
+	{"7992739871", 3, 11, 65, true},
+	{"4111111111111111", 3, 34, 93, true},
+	{"000123", 0, 26, 20, true},
+	{"3214282912345698765432161100", 7, 16, 50, true},
+	{"3214282912345698765432161182", 5, 1, 95, true},
+	{"123.000", 0, 26, 20, true},
+	{"1.5", 0, 0, 0, false},
+	{"-12", 0, 0, 0, false},
+}
+
+func TestDecChecksums(t *testing.T) {
+	for i, tt := range decChecksumTests {
+		x, _ := new(inf.Dec).SetString(tt.in)
+		luhn, err1 := x.LuhnCheckDigit()
+		mod97, err2 := x.Mod97()
+		mod97chk, err3 := x.Mod97CheckDigits()
+		if (err1 == nil) != tt.ok || (err2 == nil) != tt.ok || (err3 == nil) != tt.ok {
+			t.Errorf("#%d %v got errors %v, %v, %v; expected ok %v", i, x, err1, err2, err3, tt.ok)
+			continue
+		}
+		if !tt.ok {
+			if x.LuhnValid() {
+				t.Errorf("#%d %v LuhnValid got true; expected false", i, x)
+			}
+			continue
+		}
+		if luhn != tt.luhn || mod97 != tt.mod97 || mod97chk != tt.mod97chk {
+			t.Errorf("#%d %v got %d, %d, %d; expected %d, %d, %d",
+				i, x, luhn, mod97, mod97chk, tt.luhn, tt.mod97, tt.mod97chk)
+		}
+		// appending the check digits must produce valid numbers
+		withLuhn := new(inf.Dec).Mul(x, inf.NewDec(1, -1))
+		withLuhn.Add(withLuhn, inf.NewDec(int64(luhn), 0))
+		if !withLuhn.LuhnValid() {
+			t.Errorf("#%d %v LuhnValid got false", i, withLuhn)
+		}
+		withMod97 := new(inf.Dec).Mul(x, inf.NewDec(1, -2))
+		withMod97.Add(withMod97, inf.NewDec(int64(mod97chk), 0))
+		if r, _ := withMod97.Mod97(); r != 1 {
+			t.Errorf("#%d %v Mod97 got %d; expected 1", i, withMod97, r)
+		}
+	}
+}
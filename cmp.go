@@ -0,0 +1,202 @@
+package inf
+
+import (
+	"math"
+	"math/big"
+)
+
+// pow10int64[i] is 10**i; 10**19 and above do not fit in an int64.
+var pow10int64 = func() (t [19]int64) {
+	t[0] = 1
+	for i := 1; i < len(t); i++ {
+		t[i] = t[i-1] * 10
+	}
+	return
+}()
+
+func cmpInt64(x, y int64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return +1
+	}
+	return 0
+}
+
+func sign64(x int64) int {
+	return cmpInt64(x, 0)
+}
+
+// CmpInt64 compares x and y and returns:
+//
+//	-1 if x <  y
+//	 0 if x == y
+//	+1 if x >  y
+//
+// It does not allocate when the unscaled value of x fits in an int64.
+func (x *Dec) CmpInt64(y int64) int {
+	if sx, sy := x.Sign(), sign64(y); sx != sy {
+		return cmpInt64(int64(sx), int64(sy))
+	}
+	u := x.UnscaledBig()
+	if !u.IsInt64() {
+		var yy big.Int
+		return x.cmpInt(yy.SetInt64(y))
+	}
+	ux, s := u.Int64(), x.Scale()
+	switch {
+	case s > 0:
+		// compare integer part, then fraction
+		ix, fx := int64(0), ux
+		if int(s) < len(pow10int64) {
+			ix, fx = ux/pow10int64[s], ux%pow10int64[s]
+		}
+		if c := cmpInt64(ix, y); c != 0 {
+			return c
+		}
+		return sign64(fx)
+	case s < 0:
+		// x is a multiple of 10**(-s); y == q*10**(-s) + r
+		q, r := int64(0), y
+		if int(-s) < len(pow10int64) {
+			q, r = y/pow10int64[-s], y%pow10int64[-s]
+		}
+		if c := cmpInt64(ux, q); c != 0 {
+			return c
+		}
+		return -sign64(r)
+	}
+	return cmpInt64(ux, y)
+}
+
+// CmpUint64 compares x and y and returns:
+//
+//	-1 if x <  y
+//	 0 if x == y
+//	+1 if x >  y
+//
+// It does not allocate when y is at most math.MaxInt64 and the unscaled value
+// of x fits in an int64.
+func (x *Dec) CmpUint64(y uint64) int {
+	if y <= math.MaxInt64 {
+		return x.CmpInt64(int64(y))
+	}
+	if x.Sign() <= 0 {
+		return -1
+	}
+	var yy big.Int
+	return x.cmpInt(yy.SetUint64(y))
+}
+
+// CmpFloat64 compares x and the exact value of y and returns:
+//
+//	-1 if x <  y
+//	 0 if x == y
+//	+1 if x >  y
+//
+// Infinite values of y compare greater or less than all Dec values; y must not
+// be NaN (CmpFloat64 panics otherwise).
+//
+// Note that the exact value of y generally differs from the decimal literal
+// it was obtained from; for example, NewDec(1, 1).CmpFloat64(0.1) returns -1,
+// as the float64 value nearest to 0.1 is slightly greater than 0.1.
+func (x *Dec) CmpFloat64(y float64) int {
+	switch {
+	case math.IsNaN(y):
+		panic("Dec.CmpFloat64: NaN argument")
+	case math.IsInf(y, 0):
+		if y > 0 {
+			return -1
+		}
+		return +1
+	case y == math.Trunc(y) && math.Abs(y) < 1<<63:
+		return x.CmpInt64(int64(y))
+	}
+	if sx, sy := x.Sign(), sign64(int64(math.Copysign(1, y))); sx != sy {
+		return cmpInt64(int64(sx), int64(sy))
+	}
+	// y == m * 2**e exactly
+	fr, exp := math.Frexp(y)
+	m, e := int64(fr*(1<<53)), exp-53
+	var mm big.Int
+	mm.SetInt64(m)
+	if e >= 0 {
+		return x.cmpInt(mm.Lsh(&mm, uint(e)))
+	}
+	// compare x * 2**(-e) with m
+	var xx big.Int
+	xx.Lsh(x.UnscaledBig(), uint(-e))
+	return NewDecBig(&xx, x.Scale()).cmpInt(&mm)
+}
+
+// cmpInt compares x with the integer y.
+func (x *Dec) cmpInt(y *big.Int) int {
+	var t big.Int
+	switch s := x.Scale(); {
+	case s > 0:
+		return x.UnscaledBig().Cmp(t.Mul(y, exp10(s)))
+	case s < 0:
+		return t.Mul(x.UnscaledBig(), exp10(-s)).Cmp(y)
+	}
+	return x.UnscaledBig().Cmp(y)
+}
+
+// CmpAbs compares the absolute values of x and y and returns:
+//
+//	-1 if |x| <  |y|
+//	 0 if |x| == |y|
+//	+1 if |x| >  |y|
+//
+// Unlike comparing the results of Abs, it does not allocate when the scales
+// of x and y are equal.
+func (x *Dec) CmpAbs(y *Dec) int {
+	xx, yy := upscale(x, y)
+	return xx.UnscaledBig().CmpAbs(yy.UnscaledBig())
+}
+
+// Min returns the least of the given values, as by Cmp (so that values with
+// different scales are compared by value). Nil values are ignored, and Min
+// returns nil if all values are nil. The result is one of the arguments (not
+// a copy); of equal values, the first one is returned.
+func Min(first *Dec, rest ...*Dec) *Dec {
+	return extreme(-1, first, rest)
+}
+
+// Max returns the greatest of the given values, as by Cmp (so that values
+// with different scales are compared by value). Nil values are ignored, and
+// Max returns nil if all values are nil. The result is one of the arguments
+// (not a copy); of equal values, the first one is returned.
+func Max(first *Dec, rest ...*Dec) *Dec {
+	return extreme(+1, first, rest)
+}
+
+// extreme returns the first of the non-nil values x such that no other value
+// y satisfies y.Cmp(x) == c.
+func extreme(c int, first *Dec, rest []*Dec) *Dec {
+	m := first
+	for _, x := range rest {
+		if x != nil && (m == nil || x.Cmp(m) == c) {
+			m = x
+		}
+	}
+	return m
+}
+
+// Clamp sets z to x limited to the range [lo, hi], and returns z; that is, z
+// is set to lo if x < lo, to hi if x > hi, and to x otherwise (keeping the
+// scale of the value it is set to). lo or hi may be nil for no lower or upper
+// bound, respectively.
+//
+// Clamp returns nil if lo > hi, and the value of z is undefined in that case.
+func (z *Dec) Clamp(x, lo, hi *Dec) *Dec {
+	switch {
+	case lo != nil && hi != nil && lo.Cmp(hi) > 0:
+		return nil
+	case lo != nil && x.Cmp(lo) < 0:
+		return z.Set(lo)
+	case hi != nil && x.Cmp(hi) > 0:
+		return z.Set(hi)
+	}
+	return z.Set(x)
+}
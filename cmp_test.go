@@ -0,0 +1,172 @@
+package inf_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+// ratOf returns the exact value of x as a *big.Rat.
+func ratOf(x *inf.Dec) *big.Rat {
+	r := new(big.Rat).SetInt(x.UnscaledBig())
+	e := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(int(x.Scale())))), nil)
+	if x.Scale() > 0 {
+		return r.Quo(r, new(big.Rat).SetInt(e))
+	}
+	return r.Mul(r, new(big.Rat).SetInt(e))
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+var decCmpNumInputs = []*inf.Dec{
+	inf.NewDec(0, 0), inf.NewDec(0, 5), inf.NewDec(0, -5),
+	inf.NewDec(1, 0), inf.NewDec(-1, 0), inf.NewDec(15, 1), inf.NewDec(-15, 1),
+	inf.NewDec(1, -1), inf.NewDec(-1, -1), inf.NewDec(1, -19), inf.NewDec(1, 20),
+	inf.NewDec(math.MaxInt64, 0), inf.NewDec(math.MinInt64, 0),
+	inf.NewDec(math.MaxInt64, 3), inf.NewDec(math.MaxInt64, -3),
+	inf.NewDecBig(new(big.Int).Lsh(big.NewInt(1), 64), 0),
+	inf.NewDecBig(new(big.Int).Lsh(big.NewInt(-1), 64), 1),
+	inf.NewDecBig(new(big.Int).Lsh(big.NewInt(1), 64), -1),
+}
+
+var decCmpInt64Inputs = []int64{
+	0, 1, -1, 2, 9, 10, 11, -10, 100, 1e18, math.MaxInt64, math.MinInt64,
+}
+
+func TestDecCmpInt64(t *testing.T) {
+	for i, x := range decCmpNumInputs {
+		for j, y := range decCmpInt64Inputs {
+			exp := ratOf(x).Cmp(new(big.Rat).SetInt64(y))
+			if c := x.CmpInt64(y); c != exp {
+				t.Errorf("#%d,%d %v.CmpInt64(%d) got %d; expected %d", i, j, x, y, c, exp)
+			}
+			if y < 0 {
+				continue
+			}
+			if c := x.CmpUint64(uint64(y)); c != exp {
+				t.Errorf("#%d,%d %v.CmpUint64(%d) got %d; expected %d", i, j, x, y, c, exp)
+			}
+			yu := uint64(y) + 1<<63
+			expu := ratOf(x).Cmp(new(big.Rat).SetInt(new(big.Int).SetUint64(yu)))
+			if c := x.CmpUint64(yu); c != expu {
+				t.Errorf("#%d,%d %v.CmpUint64(%d) got %d; expected %d", i, j, x, yu, c, expu)
+			}
+		}
+	}
+}
+
+func TestDecCmpFloat64(t *testing.T) {
+	floats := []float64{0, 1, -1, 0.1, -0.1, 0.5, 1.5, -1.5, 1e-30, 1e30, -1e30,
+		math.MaxFloat64, math.SmallestNonzeroFloat64, 0x1p63, -0x1p63, 1e19}
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 20; i++ {
+		floats = append(floats, r.NormFloat64()*math.Pow(10, float64(r.Intn(40)-20)))
+	}
+	for i, x := range decCmpNumInputs {
+		for j, y := range floats {
+			exp := ratOf(x).Cmp(new(big.Rat).SetFloat64(y))
+			if c := x.CmpFloat64(y); c != exp {
+				t.Errorf("#%d,%d %v.CmpFloat64(%g) got %d; expected %d", i, j, x, y, c, exp)
+			}
+		}
+		if c := x.CmpFloat64(math.Inf(1)); c != -1 {
+			t.Errorf("#%d %v.CmpFloat64(+Inf) got %d; expected -1", i, x, c)
+		}
+		if c := x.CmpFloat64(math.Inf(-1)); c != 1 {
+			t.Errorf("#%d %v.CmpFloat64(-Inf) got %d; expected 1", i, x, c)
+		}
+	}
+	if c := inf.NewDec(1, 1).CmpFloat64(0.1); c != -1 {
+		t.Errorf("0.1.CmpFloat64(0.1) got %d; expected -1", c)
+	}
+}
+
+func TestDecCmpInt64Allocs(t *testing.T) {
+	x := inf.NewDec(12345, 2)
+	n := testing.AllocsPerRun(100, func() {
+		x.CmpInt64(123)
+		x.CmpInt64(-7)
+		x.CmpUint64(1 << 40)
+	})
+	if n != 0 {
+		t.Errorf("CmpInt64 got %v allocs; expected 0", n)
+	}
+}
+
+func TestDecCmpAbs(t *testing.T) {
+	for _, x := range decCmpNumInputs {
+		for _, y := range decCmpNumInputs {
+			exp := new(big.Rat).Abs(ratOf(x)).Cmp(new(big.Rat).Abs(ratOf(y)))
+			if c := x.CmpAbs(y); c != exp {
+				t.Errorf("%v.CmpAbs(%v) got %d; expected %d", x, y, c, exp)
+			}
+		}
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	a, b, c := inf.NewDec(15, 1), inf.NewDec(150, 2), inf.NewDec(-2, 0)
+	d := inf.NewDec(1, -1)
+	for i, tt := range []struct {
+		xs       []*inf.Dec
+		min, max *inf.Dec
+	}{
+		{[]*inf.Dec{a}, a, a},
+		{[]*inf.Dec{a, b}, a, a},
+		{[]*inf.Dec{b, a}, b, b},
+		{[]*inf.Dec{a, c, d}, c, d},
+		{[]*inf.Dec{d, a, c, b}, c, d},
+		{[]*inf.Dec{nil, a, nil, c}, c, a},
+		{[]*inf.Dec{nil}, nil, nil},
+		{[]*inf.Dec{nil, nil}, nil, nil},
+	} {
+		if m := inf.Min(tt.xs[0], tt.xs[1:]...); m != tt.min {
+			t.Errorf("#%d Min%v got %p; expected %p", i, tt.xs, m, tt.min)
+		}
+		if m := inf.Max(tt.xs[0], tt.xs[1:]...); m != tt.max {
+			t.Errorf("#%d Max%v got %p; expected %p", i, tt.xs, m, tt.max)
+		}
+	}
+}
+
+func TestDecClamp(t *testing.T) {
+	for i, tt := range []struct {
+		x, lo, hi string // empty lo or hi for nil
+		exp       string // empty if nil is expected
+	}{
+		{"0.05", "0", "0.1", "0.05"},
+		{"-0.05", "0", "0.1", "0"},
+		{"0.15", "0", "0.10", "0.10"},
+		{"0.1", "0", "0.10", "0.1"},
+		{"5", "", "3", "3"},
+		{"-5", "", "3", "-5"},
+		{"-5", "-1", "", "-1"},
+		{"5", "", "", "5"},
+		{"1", "2", "1", ""},
+	} {
+		var lo, hi *inf.Dec
+		if tt.lo != "" {
+			lo = decs(tt.lo)[0]
+		}
+		if tt.hi != "" {
+			hi = decs(tt.hi)[0]
+		}
+		x := decs(tt.x)[0]
+		z := new(inf.Dec).Clamp(x, lo, hi)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d Clamp(%v, %v, %v) got %v; expected nil", i, x, lo, hi, z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d Clamp(%v, %v, %v) got %v; expected %s", i, x, lo, hi, z, tt.exp)
+		}
+	}
+}
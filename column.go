@@ -0,0 +1,209 @@
+package inf
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ColumnFormat describes the layout of a column written by ColumnWriter.
+type ColumnFormat struct {
+	// IntWidth is the number of characters before the decimal point,
+	// including the sign.
+	IntWidth int
+	// FracWidth is the number of characters after the decimal point. If
+	// FracWidth is zero, no decimal point is written.
+	FracWidth int
+}
+
+// A ColumnWriter writes rows of Dec values as aligned text columns, with the
+// decimal points of the values in each column vertically aligned. Values
+// with fewer fractional digits than others in the same column are padded
+// with spaces on the right; trailing zeros are not added, as they would
+// change the scale of the value shown.
+//
+// By default, a ColumnWriter buffers the rows written to it, and determines
+// the layout of each column from all of its values when Flush is called.
+// When a fixed layout is set with SetLayout, each row is written
+// immediately, and values that do not fit the layout are reported as errors.
+type ColumnWriter struct {
+	// Delim is written between columns; a single space if empty. A
+	// delimiter such as "," or " | " can be used to produce delimited
+	// columns.
+	Delim string
+	// Pad is the character used to pad the integer part of values to the
+	// column width; a space if zero. Padding characters other than space
+	// are best used together with SignColumn.
+	Pad byte
+	// SignColumn, when set, causes the sign to be written at the start of
+	// the column instead of immediately before the first digit; the
+	// position is padded for non-negative values.
+	SignColumn bool
+
+	w      io.Writer
+	layout []ColumnFormat
+	rows   [][]colCell
+}
+
+type colCell struct {
+	blank, neg, point bool
+	ip, fp            string
+}
+
+// NewColumnWriter allocates and returns a new ColumnWriter writing to w.
+func NewColumnWriter(w io.Writer) *ColumnWriter {
+	return &ColumnWriter{w: w}
+}
+
+// SetLayout sets a fixed layout for the columns, and returns cw. Rows written
+// after SetLayout are written immediately. Values in columns without a
+// ColumnFormat are written without padding.
+func (cw *ColumnWriter) SetLayout(cols ...ColumnFormat) *ColumnWriter {
+	cw.layout = cols
+	return cw
+}
+
+// WriteRow writes a row with the given values; nil values produce blank
+// cells. The row is buffered until Flush is called, unless a fixed layout is
+// set.
+func (cw *ColumnWriter) WriteRow(xs ...*Dec) error {
+	row := make([]colCell, len(xs))
+	for i, x := range xs {
+		row[i] = newColCell(x)
+	}
+	if cw.layout == nil {
+		cw.rows = append(cw.rows, row)
+		return nil
+	}
+	return cw.writeRow(row, cw.layout)
+}
+
+// Flush writes the buffered rows, if any. It must be called after the last
+// call to WriteRow when no fixed layout is set.
+func (cw *ColumnWriter) Flush() error {
+	if len(cw.rows) == 0 {
+		return nil
+	}
+	var layout []ColumnFormat
+	for _, row := range cw.rows {
+		for i, c := range row {
+			if i == len(layout) {
+				layout = append(layout, ColumnFormat{})
+			}
+			f := &layout[i]
+			if w := cw.intWidth(c); w > f.IntWidth {
+				f.IntWidth = w
+			}
+			if len(c.fp) > f.FracWidth {
+				f.FracWidth = len(c.fp)
+			}
+		}
+	}
+	rows := cw.rows
+	cw.rows = nil
+	for _, row := range rows {
+		if err := cw.writeRow(row, layout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newColCell(x *Dec) colCell {
+	if x == nil {
+		return colCell{blank: true}
+	}
+	s := new(Dec).Abs(x).String()
+	c := colCell{neg: x.Sign() < 0, ip: s}
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		c.point, c.ip, c.fp = true, s[:i], s[i+1:]
+	}
+	return c
+}
+
+// intWidth returns the number of characters c needs before the decimal point.
+func (cw *ColumnWriter) intWidth(c colCell) int {
+	switch {
+	case c.blank:
+		return 0
+	case c.neg || cw.SignColumn:
+		return len(c.ip) + 1
+	}
+	return len(c.ip)
+}
+
+func (cw *ColumnWriter) writeRow(row []colCell, layout []ColumnFormat) error {
+	delim, pad := cw.Delim, cw.Pad
+	if delim == "" {
+		delim = " "
+	}
+	if pad == 0 {
+		pad = ' '
+	}
+	buf := make([]byte, 0, 64)
+	for i, c := range row {
+		if i > 0 {
+			buf = append(buf, delim...)
+		}
+		var f ColumnFormat
+		if i < len(layout) {
+			f = layout[i]
+		}
+		if c.blank {
+			buf = appendRepeat(buf, ' ', f.IntWidth)
+			if f.FracWidth > 0 {
+				buf = appendRepeat(buf, ' ', f.FracWidth+1)
+			}
+			continue
+		}
+		fits := cw.intWidth(c) <= f.IntWidth && len(c.fp) <= f.FracWidth &&
+			(!c.point || f.FracWidth > 0)
+		if !fits && i < len(cw.layout) {
+			return fmt.Errorf("ColumnWriter.WriteRow: value %s does not fit column %d", c, i)
+		}
+		n := f.IntWidth - cw.intWidth(c)
+		if cw.SignColumn {
+			if c.neg {
+				buf = append(buf, '-')
+			} else {
+				buf = append(buf, pad)
+			}
+			buf = appendRepeat(buf, pad, n)
+		} else {
+			buf = appendRepeat(buf, pad, n)
+			if c.neg {
+				buf = append(buf, '-')
+			}
+		}
+		buf = append(buf, c.ip...)
+		switch {
+		case c.point:
+			buf = append(buf, '.')
+			buf = append(buf, c.fp...)
+			buf = appendRepeat(buf, ' ', f.FracWidth-len(c.fp))
+		case f.FracWidth > 0:
+			buf = appendRepeat(buf, ' ', f.FracWidth+1)
+		}
+	}
+	buf = append(buf, '\n')
+	_, err := cw.w.Write(buf)
+	return err
+}
+
+func (c colCell) String() string {
+	s := c.ip
+	if c.neg {
+		s = "-" + s
+	}
+	if c.point {
+		s += "." + c.fp
+	}
+	return s
+}
+
+func appendRepeat(b []byte, ch byte, n int) []byte {
+	for ; n > 0; n-- {
+		b = append(b, ch)
+	}
+	return b
+}
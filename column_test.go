@@ -0,0 +1,89 @@
+package inf_test
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func decs(ss ...string) []*inf.Dec {
+	ds := make([]*inf.Dec, len(ss))
+	for i, s := range ss {
+		if s != "" {
+			ds[i], _ = new(inf.Dec).SetString(s)
+		}
+	}
+	return ds
+}
+
+var decColumnRows = [][]*inf.Dec{
+	decs("1", "12.5", "-0.001"),
+	decs("-1234.56", "3", ""),
+	decs("7.1", "-0.25", "100"),
+}
+
+var decColumnWriterTests = []struct {
+	delim      string
+	pad        byte
+	signColumn bool
+	out        string
+}{
+	{"", 0, false, "" +
+		"    1    12.5   -0.001\n" +
+		"-1234.56  3           \n" +
+		"    7.1  -0.25 100    \n"},
+	{" | ", 0, true, "" +
+		"    1    |  12.5  | -  0.001\n" +
+		"-1234.56 |   3    |         \n" +
+		"    7.1  | - 0.25 |  100    \n"},
+	{",", '*', true, "" +
+		"****1   ,*12.5 ,-**0.001\n" +
+		"-1234.56,**3   ,        \n" +
+		"****7.1 ,-*0.25,*100    \n"},
+}
+
+func TestDecColumnWriter(t *testing.T) {
+	for i, tt := range decColumnWriterTests {
+		var buf bytes.Buffer
+		w := inf.NewColumnWriter(&buf)
+		w.Delim, w.Pad, w.SignColumn = tt.delim, tt.pad, tt.signColumn
+		for _, row := range decColumnRows {
+			if err := w.WriteRow(row...); err != nil {
+				t.Fatalf("#%d WriteRow: %v", i, err)
+			}
+		}
+		if buf.Len() != 0 {
+			t.Errorf("#%d rows written before Flush", i)
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatalf("#%d Flush: %v", i, err)
+		}
+		if s := buf.String(); s != tt.out {
+			t.Errorf("#%d got\n%s\nexpected\n%s", i, s, tt.out)
+		}
+	}
+}
+
+func TestDecColumnWriterLayout(t *testing.T) {
+	var buf bytes.Buffer
+	w := inf.NewColumnWriter(&buf).SetLayout(
+		inf.ColumnFormat{IntWidth: 6, FracWidth: 2},
+		inf.ColumnFormat{IntWidth: 3})
+	if err := w.WriteRow(decs("-12.5", "100")...); err != nil {
+		t.Fatalf("WriteRow: %v", err)
+	}
+	if err := w.WriteRow(decs("", "-7")...); err != nil {
+		t.Fatalf("WriteRow: %v", err)
+	}
+	if s, exp := buf.String(), "   -12.5  100\n           -7\n"; s != exp {
+		t.Errorf("got\n%q\nexpected\n%q", s, exp)
+	}
+	for _, row := range [][]*inf.Dec{
+		decs("1234567"), decs("-123456"), decs("0.125"), decs("1", "1.5"),
+	} {
+		if err := w.WriteRow(row...); err == nil {
+			t.Errorf("WriteRow%v got no error for values not fitting the layout", row)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package inf
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// composeScale returns the scale corresponding to exponent; op is the name
+// of the calling function, for errors.
+func composeScale(op string, exponent int32) (Scale, error) {
+	if Scale(exponent) == MinScale {
+		return 0, fmt.Errorf("%s: exponent %d out of range", op, exponent)
+	}
+	return Scale(-exponent), nil
+}
+
+// Compose allocates and returns a new Dec set to the value
+//
+//	(-1)**negative * coefficient * 10**exponent
+//
+// where coefficient consists of ASCII decimal digits (such as the digits of a
+// number token from a lexer, with the decimal point removed and the exponent
+// adjusted accordingly). The scale of the result is -exponent.
+//
+// Compose returns an error if coefficient is empty or contains characters
+// other than digits, or if -exponent is out of the range of Scale.
+func Compose(negative bool, coefficient []byte, exponent int32) (*Dec, error) {
+	if len(coefficient) == 0 {
+		return nil, fmt.Errorf("Compose: empty coefficient")
+	}
+	for _, ch := range coefficient {
+		if ch < '0' || ch > '9' {
+			return nil, fmt.Errorf("Compose: invalid digit %q in coefficient", ch)
+		}
+	}
+	s, err := composeScale("Compose", exponent)
+	if err != nil {
+		return nil, err
+	}
+	return new(Dec).setDigits(negative, coefficient).SetScale(s), nil
+}
+
+// ComposeBig allocates and returns a new Dec set to the value
+//
+//	(-1)**negative * coefficient * 10**exponent
+//
+// The scale of the result is -exponent.
+//
+// ComposeBig returns an error if coefficient is negative, or if -exponent is
+// out of the range of Scale.
+func ComposeBig(negative bool, coefficient *big.Int, exponent int32) (*Dec, error) {
+	if coefficient.Sign() < 0 {
+		return nil, fmt.Errorf("ComposeBig: negative coefficient")
+	}
+	s, err := composeScale("ComposeBig", exponent)
+	if err != nil {
+		return nil, err
+	}
+	z := NewDecBig(coefficient, s)
+	if negative {
+		z.UnscaledBig().Neg(z.UnscaledBig())
+	}
+	return z, nil
+}
+
+// NewDecParts allocates and returns a new Dec set to the value
+//
+//	(-1)**negative * (intPart + fracPart/10**fracDigits)
+//
+// such as 12.34 for NewDecParts(false, 12, 34, 2), 12.05 for
+// NewDecParts(false, 12, 5, 2) and -0.5 for NewDecParts(true, 0, 5, 1). The
+// scale of the result is fracDigits.
+//
+// NewDecParts returns nil if fracDigits is negative, or if fracPart has more
+// than fracDigits digits. It panics with ErrScaleOverflow if fracDigits is
+// greater than MaxScale.
+func NewDecParts(negative bool, intPart, fracPart uint64, fracDigits int) *Dec {
+	if fracDigits < 0 {
+		return nil
+	}
+	s := checkScale(int64(fracDigits))
+	f := new(big.Int).SetUint64(fracPart)
+	e := exp10(s)
+	if f.Cmp(e) >= 0 {
+		return nil
+	}
+	z := new(Dec).SetScale(s)
+	u := z.UnscaledBig()
+	u.SetUint64(intPart)
+	u.Mul(u, e)
+	u.Add(u, f)
+	if negative {
+		u.Neg(u)
+	}
+	return z
+}
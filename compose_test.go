@@ -0,0 +1,92 @@
+package inf_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decComposeTests = []struct {
+	neg   bool
+	coeff string
+	exp   int32
+	out   string // empty if an error is expected
+}{
+	{false, "0", 0, "0"},
+	{true, "0", -2, "0.00"},
+	{false, "12345", -2, "123.45"},
+	{true, "12345", -2, "-123.45"},
+	{false, "00123", 0, "123"},
+	{false, "5", 3, "5000"},
+	{true, "123456789012345678901234567890", -30, "-0.123456789012345678901234567890"},
+	{false, "", 0, ""},
+	{false, "12a", 0, ""},
+	{false, "-12", 0, ""},
+	{false, "1.5", 0, ""},
+	{false, "1", math.MinInt32, ""},
+}
+
+func TestDecCompose(t *testing.T) {
+	for i, tt := range decComposeTests {
+		z, err := inf.Compose(tt.neg, []byte(tt.coeff), tt.exp)
+		if (err == nil) != (tt.out != "") || err == nil && z.String() != tt.out {
+			t.Errorf("#%d Compose(%v, %q, %d) got %v, %v; expected %q",
+				i, tt.neg, tt.coeff, tt.exp, z, err, tt.out)
+		}
+		if err == nil && z.Scale() != inf.Scale(-tt.exp) {
+			t.Errorf("#%d Compose(%v, %q, %d) got scale %d", i, tt.neg, tt.coeff, tt.exp, z.Scale())
+		}
+		c, ok := new(big.Int).SetString(tt.coeff, 10)
+		if !ok || c.Sign() < 0 {
+			continue
+		}
+		z, err = inf.ComposeBig(tt.neg, c, tt.exp)
+		if (err == nil) != (tt.out != "") || err == nil && z.String() != tt.out {
+			t.Errorf("#%d ComposeBig(%v, %v, %d) got %v, %v; expected %q",
+				i, tt.neg, c, tt.exp, z, err, tt.out)
+		}
+	}
+	if _, err := inf.ComposeBig(false, big.NewInt(-1), 0); err == nil {
+		t.Errorf("ComposeBig with negative coefficient got no error")
+	}
+}
+
+func TestDecNewDecParts(t *testing.T) {
+	for i, tt := range []struct {
+		negative   bool
+		intPart    uint64
+		fracPart   uint64
+		fracDigits int
+		exp        string // empty if nil is expected
+	}{
+		{false, 12, 34, 2, "12.34"},
+		{false, 12, 5, 2, "12.05"},
+		{false, 12, 0, 2, "12.00"},
+		{true, 12, 34, 2, "-12.34"},
+		{false, 0, 34, 2, "0.34"},
+		{true, 0, 5, 1, "-0.5"},
+		{true, 0, 5, 2, "-0.05"},
+		{true, 0, 0, 2, "0.00"},
+		{false, 12, 0, 0, "12"},
+		{true, 18446744073709551615, 18446744073709551615, 20,
+			"-18446744073709551615.18446744073709551615"},
+		{false, 12, 100, 2, ""},
+		{false, 12, 1, 0, ""},
+		{false, 12, 0, -1, ""},
+	} {
+		z := inf.NewDecParts(tt.negative, tt.intPart, tt.fracPart, tt.fracDigits)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d NewDecParts(%v, %d, %d, %d) got %v; expected nil",
+					i, tt.negative, tt.intPart, tt.fracPart, tt.fracDigits, z)
+			}
+			continue
+		}
+		if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d NewDecParts(%v, %d, %d, %d) got %v; expected %s",
+				i, tt.negative, tt.intPart, tt.fracPart, tt.fracDigits, z, tt.exp)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package inf
+
+import "strings"
+
+// A Condition is a set of conditions, as defined by the General Decimal
+// Arithmetic specification, that occurred in operations performed in a
+// Context.
+type Condition uint32
+
+const (
+	// Inexact indicates that a result was rounded, discarding non-zero
+	// digits.
+	Inexact Condition = 1 << iota
+	// Rounded indicates that a result was rounded to the precision of the
+	// Context, discarding digits which may all be zero.
+	Rounded
+	// DivisionByZero indicates a division of a non-zero dividend by zero.
+	DivisionByZero
+	// InvalidOperation indicates an operation without a defined result, such
+	// as the division of zero by zero.
+	InvalidOperation
+	// Overflow indicates that the exponent of the most significant digit of
+	// a result exceeded the MaxExponent of the Context.
+	Overflow
+)
+
+var conditionNames = []string{"Inexact", "Rounded", "DivisionByZero",
+	"InvalidOperation", "Overflow"}
+
+// String returns the names of the conditions in c separated by "|", such as
+// "Inexact|Rounded", or "" if c is empty.
+func (c Condition) String() string {
+	var names []string
+	for i, name := range conditionNames {
+		if c&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// A Context holds a precision and a Rounder that are applied to the results
+// of its operations, so that they need not be repeated at each call site, and
+// records the conditions that occur in its operations. It is modeled on the
+// context of the General Decimal Arithmetic specification (as used by
+// Python's decimal module), but the results are Decs, so that a Context can
+// be used for parts of a calculation only.
+//
+// Conditions listed in Traps are trap-enabled: an operation in which such a
+// condition occurs returns nil, and records a *ConditionError in Err. Handler
+// is called for all conditions, which allows collecting metrics or logging
+// without trapping.
+//
+// The zero value for a Context has unlimited precision and exponent range,
+// rounds half to even and traps no conditions. Operations update Flags, so a
+// Context must not be used concurrently.
+type Context struct {
+	// Precision is the maximum number of significant digits in results, or
+	// 0 for unlimited precision.
+	Precision int
+	// Rounder is used for rounding results to Precision; nil means
+	// RoundHalfEven.
+	Rounder Rounder
+	// MaxExponent is the maximum exponent of the most significant digit of
+	// results (999 for results below 1E+1000), or 0 for no limit.
+	MaxExponent int
+	// NonNegScale, if true, guarantees that results have non-negative
+	// scales: results with negative scales (such as 1.2E+5 from rounding
+	// 123456 to a precision of 2) are materialized with the scale 0 (as
+	// 120000), as by Dec.NonNegScale. The value, and so the conditions, are
+	// not affected, but the unscaled value may have trailing zeros beyond
+	// Precision.
+	NonNegScale bool
+	// Flags accumulates the conditions that occurred in operations. The
+	// Context only sets conditions; clear Flags to reset them.
+	Flags Condition
+	// Traps is the set of trap-enabled conditions.
+	Traps Condition
+	// Handler, if not nil, is called with the name of the operation (such as
+	// "Quo") and the conditions whenever conditions occur in an operation,
+	// before Flags, Traps and Err are applied.
+	Handler func(op string, c Condition)
+	// Err is the error for the first operation in which a trap-enabled
+	// condition occurred, or nil. The Context only sets Err if it is nil.
+	Err error
+}
+
+// A ConditionError records trap-enabled conditions that occurred in an
+// operation in a Context.
+type ConditionError struct {
+	Op        string    // operation, such as "Quo"
+	Condition Condition // trap-enabled conditions that occurred
+}
+
+func (e *ConditionError) Error() string {
+	return "Context." + e.Op + ": " + e.Condition.String()
+}
+
+func (c *Context) rounder() Rounder {
+	if c.Rounder == nil {
+		return RoundHalfEven
+	}
+	return c.Rounder
+}
+
+// Round sets z to x rounded to the precision of c, and returns z. Values
+// with no more significant digits than the precision are set to z unchanged,
+// keeping their scale. If the Rounder returns nil (as RoundExact does for
+// inexact results), Round returns nil and the value of z is unchanged.
+func (c *Context) Round(z, x *Dec) *Dec {
+	return c.result("Round", z, x, 0)
+}
+
+// round returns x rounded to the precision of c, or nil if the Rounder
+// returns nil, and the conditions that occurred.
+func (c *Context) round(x *Dec) (*Dec, Condition) {
+	p := c.Precision
+	if p <= 0 || x.Precision() <= p {
+		return x, 0
+	}
+	s := checkScale(int64(x.Scale()) - int64(x.Precision()-p))
+	zz := new(Dec).Round(x, s, c.rounder())
+	if zz == nil {
+		return nil, Inexact | Rounded
+	}
+	cond := Rounded
+	if zz.Cmp(x) != 0 {
+		cond |= Inexact
+	}
+	if zz.Precision() > p {
+		// rounding carried into a new digit; the last digit is zero
+		zz.Round(zz, checkScale(int64(s)-1), RoundDown)
+	}
+	return zz, cond
+}
+
+// result sets z to x (or nil) rounded to the precision of c, and applies
+// the conditions cond of the operation op, together with those from
+// rounding, to c. It returns z, or nil if x is nil, the Rounder returns nil
+// or a trap-enabled condition occurred.
+func (c *Context) result(op string, z, x *Dec, cond Condition) *Dec {
+	if x != nil {
+		var rcond Condition
+		x, rcond = c.round(x)
+		cond |= rcond
+	}
+	if x != nil && c.MaxExponent > 0 && x.Sign() != 0 &&
+		int64(x.Precision())-1-int64(x.Scale()) > int64(c.MaxExponent) {
+		x, cond = nil, cond|Overflow
+	}
+	if x != nil && c.NonNegScale && x.Scale() < 0 {
+		x = new(Dec).NonNegScale(x)
+	}
+	if cond != 0 {
+		if c.Handler != nil {
+			c.Handler(op, cond)
+		}
+		c.Flags |= cond
+		if t := cond & c.Traps; t != 0 {
+			if c.Err == nil {
+				c.Err = &ConditionError{op, t}
+			}
+			return nil
+		}
+	}
+	if x == nil {
+		return nil
+	}
+	return z.Set(x)
+}
+
+// Add sets z to the sum x+y rounded to the precision of c, and returns z.
+func (c *Context) Add(z, x, y *Dec) *Dec {
+	return c.result("Add", z, new(Dec).Add(x, y), 0)
+}
+
+// Sub sets z to the difference x-y rounded to the precision of c, and
+// returns z.
+func (c *Context) Sub(z, x, y *Dec) *Dec {
+	return c.result("Sub", z, new(Dec).Sub(x, y), 0)
+}
+
+// Mul sets z to the product x*y rounded to the precision of c, and returns z.
+func (c *Context) Mul(z, x, y *Dec) *Dec {
+	return c.result("Mul", z, new(Dec).Mul(x, y), 0)
+}
+
+// Quo sets z to the quotient x/y rounded to the precision of c, and returns
+// z. A quotient that is a finite decimal with no more significant digits than
+// the precision is exact, with the scale chosen as by QuoExact.
+//
+// With unlimited precision, Quo returns nil (and sets the Inexact condition)
+// if x/y is not a finite decimal. Quo also returns nil if the Rounder does.
+// Division by zero returns nil, with the InvalidOperation condition if x is
+// zero, and the DivisionByZero condition otherwise.
+func (c *Context) Quo(z, x, y *Dec) *Dec {
+	switch {
+	case y.Sign() == 0 && x.Sign() == 0:
+		return c.result("Quo", z, nil, InvalidOperation)
+	case y.Sign() == 0:
+		return c.result("Quo", z, nil, DivisionByZero)
+	}
+	if q := new(Dec).QuoExact(x, y); q != nil {
+		return c.result("Quo", z, q, 0)
+	}
+	if c.Precision <= 0 {
+		return c.result("Quo", z, nil, Inexact)
+	}
+	q := new(Dec).Quo(x, y, ScaleSignificantDigits(c.Precision), c.rounder())
+	return c.result("Quo", z, q, Inexact|Rounded)
+}
+
+// Neg sets z to -x rounded to the precision of c, and returns z.
+func (c *Context) Neg(z, x *Dec) *Dec {
+	return c.result("Neg", z, new(Dec).Neg(x), 0)
+}
+
+// Abs sets z to |x| rounded to the precision of c, and returns z.
+func (c *Context) Abs(z, x *Dec) *Dec {
+	return c.result("Abs", z, new(Dec).Abs(x), 0)
+}
+
+// SetString sets z to the value of s (as by Dec.SetString) rounded to the
+// precision of c, and returns z and a boolean indicating success.
+func (c *Context) SetString(z *Dec, s string) (*Dec, bool) {
+	x, ok := new(Dec).SetString(s)
+	if !ok {
+		return nil, false
+	}
+	if x = c.result("SetString", z, x, 0); x == nil {
+		return nil, false
+	}
+	return x, true
+}
@@ -0,0 +1,158 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var contextTests = []struct {
+	op    string
+	x, y  string
+	prec  int
+	exp   string
+	flags inf.Condition
+}{
+	{"add", "1.23", "4.56", 0, "5.79", 0},
+	{"add", "1.23", "4.56", 3, "5.79", 0},
+	{"add", "1.23", "4.56", 2, "5.8", inf.Inexact | inf.Rounded},
+	{"add", "9.99", "0.005", 3, "10.0", inf.Inexact | inf.Rounded},
+	{"add", "9223372036854775807", "0", 19, "9223372036854775807", 0},
+	{"sub", "1.20", "0.20", 2, "1.0", inf.Rounded},
+	{"mul", "1.5", "1.5", 2, "2.2", inf.Inexact | inf.Rounded},
+	{"mul", "123", "1000", 2, "120000", inf.Inexact | inf.Rounded},
+	{"quo", "1", "4", 5, "0.25", 0},
+	{"quo", "1", "4", 0, "0.25", 0},
+	{"quo", "1", "3", 5, "0.33333", inf.Inexact | inf.Rounded},
+	{"quo", "2", "3", 5, "0.66667", inf.Inexact | inf.Rounded},
+	{"quo", "-2000", "3", 2, "-670", inf.Inexact | inf.Rounded},
+	{"quo", "0.999", "1", 2, "1.0", inf.Inexact | inf.Rounded},
+	{"quo", "1", "3", 0, "<nil>", inf.Inexact},
+	{"neg", "12.345", "", 4, "-12.34", inf.Inexact | inf.Rounded},
+	{"abs", "-12.355", "", 4, "12.36", inf.Inexact | inf.Rounded},
+}
+
+func TestContext(t *testing.T) {
+	for i, tt := range contextTests {
+		c := &inf.Context{Precision: tt.prec}
+		x, _ := new(inf.Dec).SetString(tt.x)
+		y, _ := new(inf.Dec).SetString(tt.y)
+		var z *inf.Dec
+		switch tt.op {
+		case "add":
+			z = c.Add(new(inf.Dec), x, y)
+		case "sub":
+			z = c.Sub(new(inf.Dec), x, y)
+		case "mul":
+			z = c.Mul(new(inf.Dec), x, y)
+		case "quo":
+			z = c.Quo(new(inf.Dec), x, y)
+		case "neg":
+			z = c.Neg(new(inf.Dec), x)
+		case "abs":
+			z = c.Abs(new(inf.Dec), x)
+		}
+		if z.String() != tt.exp || c.Flags != tt.flags {
+			t.Errorf("#%d %s(%s, %s) at precision %d got %v [%v]; expected %s [%v]",
+				i, tt.op, tt.x, tt.y, tt.prec, z, c.Flags, tt.exp, tt.flags)
+		}
+	}
+}
+
+func TestContextRounder(t *testing.T) {
+	c := &inf.Context{Precision: 3, Rounder: inf.RoundFloor}
+	if z := c.Quo(new(inf.Dec), inf.NewDec(-2, 0), inf.NewDec(3, 0)); z.String() != "-0.667" {
+		t.Errorf("Quo(-2, 3) got %v; expected -0.667", z)
+	}
+	c = &inf.Context{Precision: 3, Rounder: inf.RoundExact}
+	if z := c.Add(new(inf.Dec), inf.NewDec(1000, 0), inf.NewDec(1, 0)); z != nil || c.Flags != inf.Inexact|inf.Rounded {
+		t.Errorf("Add(1000, 1) with RoundExact got %v [%v]; expected <nil> [Inexact|Rounded]", z, c.Flags)
+	}
+	// flags accumulate until cleared
+	c = &inf.Context{Precision: 2}
+	c.Mul(new(inf.Dec), inf.NewDec(15, 1), inf.NewDec(15, 1))
+	c.Add(new(inf.Dec), inf.NewDec(1, 0), inf.NewDec(1, 0))
+	if c.Flags != inf.Inexact|inf.Rounded {
+		t.Errorf("Flags got %v; expected Inexact|Rounded", c.Flags)
+	}
+	if z, ok := c.SetString(new(inf.Dec), "3.14159"); !ok || z.String() != "3.1" {
+		t.Errorf("SetString(3.14159) got %v, %v; expected 3.1, true", z, ok)
+	}
+	if s := inf.Condition(0).String(); s != "" {
+		t.Errorf("Condition(0).String() got %q; expected \"\"", s)
+	}
+}
+
+func TestContextNonNegScale(t *testing.T) {
+	c := &inf.Context{Precision: 2, NonNegScale: true}
+	for i, tt := range []struct {
+		z     *inf.Dec
+		exp   string
+		scale inf.Scale
+	}{
+		{c.Add(new(inf.Dec), inf.NewDec(123400, 0), inf.NewDec(56, 0)), "120000", 0},
+		{c.Mul(new(inf.Dec), inf.NewDec(15, 1), inf.NewDec(15, 1)), "2.2", 1},
+		{c.Quo(new(inf.Dec), inf.NewDec(1, -3), inf.NewDec(3, 0)), "330", 0},
+		{c.Round(new(inf.Dec), inf.NewDec(5, -2)), "500", 0},
+	} {
+		if tt.z.String() != tt.exp || tt.z.Scale() != tt.scale {
+			t.Errorf("#%d got %v with scale %d; expected %s with scale %d", i, tt.z, tt.z.Scale(), tt.exp, tt.scale)
+		}
+	}
+	// conditions are those of the rounded value
+	if c.Flags != inf.Inexact|inf.Rounded {
+		t.Errorf("Flags got %v; expected Inexact|Rounded", c.Flags)
+	}
+}
+
+func TestContextTraps(t *testing.T) {
+	var ops []string
+	c := &inf.Context{
+		Precision:   4,
+		MaxExponent: 5,
+		Traps:       inf.DivisionByZero | inf.Overflow,
+		Handler: func(op string, cond inf.Condition) {
+			ops = append(ops, op+" "+cond.String())
+		},
+	}
+	// not trapped
+	if z := c.Quo(new(inf.Dec), inf.NewDec(1, 0), inf.NewDec(3, 0)); z.String() != "0.3333" {
+		t.Errorf("Quo(1, 3) got %v; expected 0.3333", z)
+	}
+	if z := c.Quo(new(inf.Dec), inf.NewDec(0, 0), inf.NewDec(0, 0)); z != nil || c.Err != nil {
+		t.Errorf("Quo(0, 0) got %v, %v; expected <nil>, <nil>", z, c.Err)
+	}
+	// trapped
+	if z := c.Quo(new(inf.Dec), inf.NewDec(1, 0), inf.NewDec(0, 0)); z != nil {
+		t.Errorf("Quo(1, 0) got %v; expected <nil>", z)
+	}
+	if err, ok := c.Err.(*inf.ConditionError); !ok || err.Op != "Quo" || err.Condition != inf.DivisionByZero {
+		t.Errorf("Err got %v; expected Quo DivisionByZero", c.Err)
+	} else if s := err.Error(); s != "Context.Quo: DivisionByZero" {
+		t.Errorf("Error() got %q", s)
+	}
+	// within and beyond MaxExponent; Err keeps the first error
+	if z := c.Mul(new(inf.Dec), inf.NewDec(999, 0), inf.NewDec(999, 0)); z.String() != "998000" {
+		t.Errorf("Mul(999, 999) got %v; expected 998000", z)
+	}
+	if z := c.Mul(new(inf.Dec), inf.NewDec(1000, 0), inf.NewDec(1000, 0)); z != nil {
+		t.Errorf("Mul(1000, 1000) got %v; expected <nil>", z)
+	}
+	if err := c.Err.(*inf.ConditionError); err.Condition != inf.DivisionByZero {
+		t.Errorf("Err got %v; expected the first error", err)
+	}
+	exp := []string{"Quo Inexact|Rounded", "Quo InvalidOperation", "Quo DivisionByZero",
+		"Mul Inexact|Rounded", "Mul Rounded|Overflow"}
+	if len(ops) != len(exp) {
+		t.Fatalf("Handler calls got %q; expected %q", ops, exp)
+	}
+	for i := range exp {
+		if ops[i] != exp[i] {
+			t.Errorf("Handler call #%d got %q; expected %q", i, ops[i], exp[i])
+		}
+	}
+	exp2 := inf.Inexact | inf.Rounded | inf.InvalidOperation | inf.DivisionByZero | inf.Overflow
+	if c.Flags != exp2 {
+		t.Errorf("Flags got %v; expected %v", c.Flags, exp2)
+	}
+}
@@ -0,0 +1,35 @@
+package inf
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// AppendCQL appends the representation of x in the wire format of the CQL
+// (Cassandra Query Language) decimal type to dst, and returns the extended
+// buffer. The format is the scale as a 4-byte big-endian signed integer,
+// followed by the unscaled value as a minimal big-endian two's complement
+// varint (see BigDecimalBytes).
+//
+// AppendCQL and SetCQL can be used to implement gocql's Marshaler and
+// Unmarshaler interfaces for types based on Dec.
+func (x *Dec) AppendCQL(dst []byte) []byte {
+	var s [4]byte
+	binary.BigEndian.PutUint32(s[:], uint32(x.Scale()))
+	dst = append(dst, s[:]...)
+	return append(dst, twosComplement(x.UnscaledBig())...)
+}
+
+// SetCQL sets z to the value represented by data in the wire format of the
+// CQL decimal type (see AppendCQL), and returns z. An empty unscaled value is
+// accepted as zero.
+//
+// SetCQL returns an error if data is shorter than the scale; the value of z
+// is undefined in that case.
+func (z *Dec) SetCQL(data []byte) (*Dec, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("Dec.SetCQL: expected at least 4 bytes, got %d", len(data))
+	}
+	setTwosComplement(z.UnscaledBig(), data[4:])
+	return z.SetScale(Scale(int32(binary.BigEndian.Uint32(data)))), nil
+}
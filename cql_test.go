@@ -0,0 +1,36 @@
+package inf_test
+
+import (
+	"bytes"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecCQL(t *testing.T) {
+	for i, tt := range []struct {
+		x    *inf.Dec
+		data []byte
+	}{
+		{inf.NewDec(0, 0), []byte{0, 0, 0, 0, 0}},
+		{inf.NewDec(12345, 2), []byte{0, 0, 0, 2, 0x30, 0x39}},
+		{inf.NewDec(-12345, 2), []byte{0, 0, 0, 2, 0xcf, 0xc7}},
+		{inf.NewDec(128, 0), []byte{0, 0, 0, 0, 0, 0x80}},
+		{inf.NewDec(-128, 0), []byte{0, 0, 0, 0, 0x80}},
+		{inf.NewDec(1, -3), []byte{0xff, 0xff, 0xff, 0xfd, 1}},
+	} {
+		if b := tt.x.AppendCQL([]byte{9}); !bytes.Equal(b, append([]byte{9}, tt.data...)) {
+			t.Errorf("#%d AppendCQL(%v) got %x; expected %x", i, tt.x, b[1:], tt.data)
+		}
+		z, err := new(inf.Dec).SetCQL(tt.data)
+		if err != nil || z.Cmp(tt.x) != 0 || z.Scale() != tt.x.Scale() {
+			t.Errorf("#%d SetCQL(%x) got %v, %v; expected %v", i, tt.data, z, err, tt.x)
+		}
+	}
+	if z, err := new(inf.Dec).SetCQL([]byte{0, 0, 0, 3}); err != nil || z.Sign() != 0 || z.Scale() != 3 {
+		t.Errorf("SetCQL with empty unscaled value got %v, %v; expected 0.000", z, err)
+	}
+	if _, err := new(inf.Dec).SetCQL([]byte{0, 0, 0}); err == nil {
+		t.Errorf("SetCQL with short data succeeded")
+	}
+}
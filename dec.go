@@ -8,10 +8,14 @@
 //
 // This package is currently in experimental stage and the API may change.
 //
+// Rounding to specific precisions (as opposed to specific decimal positions)
+// is supported through Context, which applies a precision and a Rounder to
+// the results of its operations; otherwise each rounding must be explicit.
+//
+// NaN and Inf values, and negative zero, are supported by ExtDec only; Dec
+// itself represents finite values without distinguishing negative zero.
+//
 // This package does NOT support:
-//  - rounding to specific precisions (as opposed to specific decimal positions)
-//  - the notion of context (each rounding must be explicit)
-//  - NaN and Inf values, and distinguishing between positive and negative zero
 //  - conversions to and from float32/64 types
 //
 // Features considered for possible addition:
@@ -28,6 +32,7 @@ package inf // import "gopkg.in/inf.v0"
 import (
 	"fmt"
 	"io"
+	"math"
 	"math/big"
 	"strings"
 )
@@ -86,16 +91,53 @@ type Dec struct {
 }
 
 // Scale represents the type used for the scale of a Dec.
+//
+// Scales are in the range [MinScale, MaxScale], and are never silently
+// wrapped around or clamped by operations:
+//
+//   - Operations that would produce a result with a scale out of the range
+//     (such as Mul with operands whose scales add up to more than MaxScale),
+//     or that would need a power of ten 10**n with n out of the range for an
+//     intermediate value (such as Add with operands with the scales MinScale
+//     and MaxScale), panic with ErrScaleOverflow. The error-returning
+//     variants (such as MulErr and QuoErr) return it instead, and Context
+//     operations are subject to the same rules. This includes functions
+//     taking an int (or uint) that determines a scale, such as NewDecPow10
+//     and SetQ.
+//   - Parsing and decoding functions (such as SetString, SetDynamoDBNumber
+//     and Compose) fail for inputs with scales out of the range.
+//   - Reduce, which only changes the representation of a value, keeps the
+//     scale within the range, leaving trailing zeros in the unscaled value if
+//     necessary.
+//
+// Scales near the limits are mostly useful for representing values without
+// materializing them; see also MaxDigits.
 type Scale int32
 
+// MaxScale and MinScale are the greatest and least values of Scale.
+const (
+	MaxScale Scale = math.MaxInt32
+	MinScale Scale = math.MinInt32
+)
+
 const scaleSize = 4 // bytes in a Scale value
 
 // Scaler represents a method for obtaining the scale to use for the result of
 // an operation on x and y.
-type scaler interface {
+type Scaler interface {
 	Scale(x *Dec, y *Dec) Scale
 }
 
+// ScaleFixed returns a Scaler that always returns the scale s.
+func ScaleFixed(s Scale) Scaler {
+	return sclr{s}
+}
+
+// ScaleQuoExact is a Scaler for quotients that returns a scale at which x/y
+// is exact whenever it is a finite decimal (as used by QuoExact). Its Scale
+// method panics with ErrDivisionByZero if y is zero.
+var ScaleQuoExact Scaler = scaleQuoExact{}
+
 var bigInt = [...]*big.Int{
 	big.NewInt(0), big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4),
 	big.NewInt(5), big.NewInt(6), big.NewInt(7), big.NewInt(8), big.NewInt(9),
@@ -123,8 +165,18 @@ func NewDecBig(unscaled *big.Int, scale Scale) *Dec {
 	return new(Dec).SetUnscaledBig(unscaled).SetScale(scale)
 }
 
+// checkNil panics with ErrNilDec if x is nil. It is called by the accessors
+// below, through which operations access the values of their operands and
+// results, so that a nil *Dec is reported clearly.
+func (x *Dec) checkNil() {
+	if x == nil {
+		panic(ErrNilDec)
+	}
+}
+
 // Scale returns the scale of x.
 func (x *Dec) Scale() Scale {
+	x.checkNil()
 	return x.scale
 }
 
@@ -133,6 +185,7 @@ func (x *Dec) Scale() Scale {
 // int64 value for u and false for ok. Use x.UnscaledBig().Int64() to avoid
 // checking the validity of the value when the check is known to be redundant.
 func (x *Dec) Unscaled() (u int64, ok bool) {
+	x.checkNil()
 	u = x.unscaled.Int64()
 	var i big.Int
 	ok = i.SetInt64(u).Cmp(&x.unscaled) == 0
@@ -141,6 +194,7 @@ func (x *Dec) Unscaled() (u int64, ok bool) {
 
 // UnscaledBig returns the unscaled value of x as *big.Int.
 func (x *Dec) UnscaledBig() *big.Int {
+	x.checkNil()
 	return &x.unscaled
 }
 
@@ -149,6 +203,7 @@ func (x *Dec) UnscaledBig() *big.Int {
 // The mathematical value of the Dec changes as if it was multiplied by
 // 10**(oldscale-scale).
 func (z *Dec) SetScale(scale Scale) *Dec {
+	z.checkNil()
 	z.scale = scale
 	return z
 }
@@ -156,6 +211,7 @@ func (z *Dec) SetScale(scale Scale) *Dec {
 // SetUnscaled sets the unscaled value of z, with the scale unchanged, and
 // returns z.
 func (z *Dec) SetUnscaled(unscaled int64) *Dec {
+	z.checkNil()
 	z.unscaled.SetInt64(unscaled)
 	return z
 }
@@ -163,6 +219,7 @@ func (z *Dec) SetUnscaled(unscaled int64) *Dec {
 // SetUnscaledBig sets the unscaled value of z, with the scale unchanged, and
 // returns z.
 func (z *Dec) SetUnscaledBig(unscaled *big.Int) *Dec {
+	z.checkNil()
 	z.unscaled.Set(unscaled)
 	return z
 }
@@ -233,15 +290,49 @@ func (z *Dec) Sub(x, y *Dec) *Dec {
 // Mul sets z to the product x*y and returns z.
 // The scale of z is the sum of the scales of x and y.
 func (z *Dec) Mul(x, y *Dec) *Dec {
-	z.SetScale(x.Scale() + y.Scale())
+	z.SetScale(checkScale(int64(x.Scale()) + int64(y.Scale())))
 	z.UnscaledBig().Mul(x.UnscaledBig(), y.UnscaledBig())
 	return z
 }
 
 // Round sets z to the value of x rounded to Scale s using Rounder r, and
 // returns z.
+//
+// If the rounder is RoundExact but x can not be expressed exactly at the
+// specified scale, Round returns nil, and the value of z is undefined.
 func (z *Dec) Round(x *Dec, s Scale, r Rounder) *Dec {
-	return z.QuoRound(x, NewDec(1, 0), s, r)
+	var q *Dec
+	var rA, rB *big.Int
+	if d := checkScale(int64(x.Scale()) - int64(s)); d > 0 {
+		q = NewDecBig(new(big.Int), s)
+		if r.UseRemainder() {
+			if h := remainderHint(r); h == RemainderFull {
+				rA, rB = new(big.Int), new(big.Int).Set(exp10(d))
+				q.UnscaledBig().QuoRem(x.UnscaledBig(), exp10(d), rA)
+			} else {
+				var rem big.Int
+				q.UnscaledBig().QuoRem(x.UnscaledBig(), exp10(d), &rem)
+				rA, rB = hintRemainder(h, &rem, exp10(d))
+			}
+		} else {
+			q.UnscaledBig().Quo(x.UnscaledBig(), exp10(d))
+		}
+	} else {
+		// no digits are discarded
+		q = new(Dec).Set(x.rescale(s))
+		switch {
+		case !r.UseRemainder():
+		case remainderHint(r) != RemainderFull:
+			rA, rB = fracRems[fracZero][0], fracRems[fracZero][1]
+		default:
+			rA, rB = new(big.Int), big.NewInt(1)
+		}
+	}
+	zz := r.Round(new(Dec), q, rA, rB)
+	if zz == nil {
+		return nil
+	}
+	return z.Set(zz)
 }
 
 // QuoRound sets z to the quotient x/y, rounded using the given Rounder to the
@@ -249,6 +340,7 @@ func (z *Dec) Round(x *Dec, s Scale, r Rounder) *Dec {
 //
 // If the rounder is RoundExact but the result can not be expressed exactly at
 // the specified scale, QuoRound returns nil, and the value of z is undefined.
+// QuoRound also returns nil if y is zero (see Quo).
 //
 // There is no corresponding Div method; the equivalent can be achieved through
 // the choice of Rounder used.
@@ -257,11 +349,86 @@ func (z *Dec) QuoRound(x, y *Dec, s Scale, r Rounder) *Dec {
 	return z.quo(x, y, sclr{s}, r)
 }
 
-func (z *Dec) quo(x, y *Dec, s scaler, r Rounder) *Dec {
+// Quo sets z to the quotient x/y, rounded using the given Rounder to the scale
+// obtained from the given Scaler, and returns z.
+//
+// If the rounder is RoundExact but the result can not be expressed exactly at
+// the scale obtained, Quo returns nil, and the value of z is undefined.
+//
+// Division by zero returns nil (without calling the Scaler or the Rounder),
+// and the value of z is undefined; this applies to all quotient methods that
+// return a *Dec, and QuoPeriodic. Rem, Mod and DivMod, whose results are not
+// quotients, panic with ErrDivisionByZero instead. Use ExtDec for quotients
+// of ±Infinity, or Context for recording the DivisionByZero condition.
+func (z *Dec) Quo(x, y *Dec, s Scaler, r Rounder) *Dec {
+	return z.quo(x, y, s, r)
+}
+
+// Inv sets z to the reciprocal 1/x, rounded using the given Rounder to the
+// scale obtained from the given Scaler (which is called with 1 and x), and
+// returns z. The reciprocal of a power of ten is obtained by adjusting the
+// scale only, without division.
+//
+// Inv returns nil if x is zero, or if the rounder is RoundExact but the result
+// can not be expressed exactly at the scale obtained; the value of z is
+// undefined in that case.
+func (z *Dec) Inv(x *Dec, s Scaler, r Rounder) *Dec {
+	if x.Sign() == 0 {
+		return nil
+	}
+	one := NewDec(1, 0)
+	if u, us := x.reduced(); u.CmpAbs(bigInt[1]) == 0 {
+		// 1/(±10**-us) == ±10**us
+		scl := s.Scale(one, x)
+		return z.Round(NewDec(int64(u.Sign()), checkScale(-int64(us))), scl, r)
+	}
+	return z.quo(one, x, s, r)
+}
+
+// QuoFlag sets z to the quotient x/y, rounded using the given Rounder to the
+// scale obtained from the given Scaler, and returns z, as Quo does. inexact
+// reports whether rounding discarded a non-zero remainder; that is, whether
+// z differs from the exact quotient.
+//
+// If the rounder is RoundExact but the result can not be expressed exactly at
+// the scale obtained, QuoFlag returns nil (and true), and the value of z is
+// undefined. If y is zero, QuoFlag returns nil and false.
+func (z *Dec) QuoFlag(x, y *Dec, s Scaler, r Rounder) (zz *Dec, inexact bool) {
+	if y.Sign() == 0 {
+		return nil, false
+	}
+	q, rA, rB := new(Dec).QuoRem(x, y, s.Scale(x, y))
+	inexact = rA.Sign() != 0
+	if !r.UseRemainder() {
+		rA, rB = nil, nil
+	}
+	if zz = r.Round(new(Dec), q, rA, rB); zz == nil {
+		return nil, inexact
+	}
+	return z.Set(zz), inexact
+}
+
+// QuoInterval returns the quotient x/y rounded to the scale obtained from
+// the given Scaler towards -infinity (lo) and towards +infinity (hi), as by
+// Quo with RoundFloor and RoundCeil, from a single division. The exact
+// quotient is in the interval [lo, hi]; lo and hi are equal if it can be
+// expressed exactly at the scale obtained. If y is zero, both are nil.
+func QuoInterval(x, y *Dec, s Scaler) (lo, hi *Dec) {
+	if y.Sign() == 0 {
+		return nil, nil
+	}
+	q, rA, rB := new(Dec).QuoRem(x, y, s.Scale(x, y))
+	return RoundFloor.Round(new(Dec), q, rA, rB), RoundCeil.Round(new(Dec), q, rA, rB)
+}
+
+func (z *Dec) quo(x, y *Dec, s Scaler, r Rounder) *Dec {
+	if y.Sign() == 0 {
+		return nil
+	}
 	scl := s.Scale(x, y)
 	var zzz *Dec
 	if r.UseRemainder() {
-		zz, rA, rB := new(Dec).quoRem(x, y, scl, true, new(big.Int), new(big.Int))
+		zz, rA, rB := new(Dec).quoHint(x, y, scl, remainderHint(r))
 		zzz = r.Round(new(Dec), zz, rA, rB)
 	} else {
 		zz, _, _ := new(Dec).quoRem(x, y, scl, false, nil, nil)
@@ -274,7 +441,8 @@ func (z *Dec) quo(x, y *Dec, s scaler, r Rounder) *Dec {
 }
 
 // QuoExact sets z to the quotient x/y and returns z when x/y is a finite
-// decimal. Otherwise it returns nil and the value of z is undefined.
+// decimal. Otherwise (including when y is zero) it returns nil and the value
+// of z is undefined.
 //
 // The scale of a non-nil result is "x.Scale() - y.Scale()" or greater; it is
 // calculated so that the remainder will be zero whenever x/y is a finite
@@ -283,6 +451,53 @@ func (z *Dec) QuoExact(x, y *Dec) *Dec {
 	return z.quo(x, y, scaleQuoExact{}, RoundExact)
 }
 
+// MulQuo sets z to x*y/d, rounded using the given Rounder to the specified
+// scale, and returns z. The product x*y is calculated exactly, so that the
+// result is rounded only once (as opposed to rounding both the product and
+// the quotient).
+//
+// If the rounder is RoundExact but the result can not be expressed exactly at
+// the specified scale, MulQuo returns nil, and the value of z is undefined.
+func (z *Dec) MulQuo(x, y, d *Dec, s Scale, r Rounder) *Dec {
+	return z.QuoRound(new(Dec).Mul(x, y), d, s, r)
+}
+
+// MulAdd sets z to x*y+a, rounded using the given Rounder to the scale
+// obtained from the given Scaler, and returns z. The Scaler is called with the
+// exact product x*y and a. The sum is calculated exactly, so that the result
+// is rounded only once; this keeps the scale of z bounded when accumulating
+// many terms, such as z.MulAdd(z, rate, z, ScaleFixed(s), r).
+//
+// If the rounder is RoundExact but the result can not be expressed exactly at
+// the scale obtained, MulAdd returns nil, and the value of z is undefined.
+func (z *Dec) MulAdd(x, y, a *Dec, s Scaler, r Rounder) *Dec {
+	p := new(Dec).Mul(x, y)
+	scl := s.Scale(p, a)
+	return z.Round(p.Add(p, a), scl, r)
+}
+
+// QuoRem sets z to the quotient x/y with the scale s, truncated towards zero,
+// and returns z along with the numerator and denominator of the remainder.
+//
+// The remainder is normalized to the range -1 < r < 1, in units of the last
+// digit of z; that is, the results satisfy the following equation:
+//
+//	x / y = z + (remNum/remDen) * 10**(-z.Scale())
+//
+// The remainder has the sign of x/y (or is zero), although the signs of
+// remNum and remDen are not individually normalized. These are the values
+// passed to Rounder.Round, so that QuoRem can be used to implement rounders
+// and related calculations outside the package.
+//
+// If y is zero, QuoRem returns nil for all results, and the value of z is
+// undefined.
+func (z *Dec) QuoRem(x, y *Dec, s Scale) (*Dec, *big.Int, *big.Int) {
+	if y.Sign() == 0 {
+		return nil, nil, nil
+	}
+	return z.quoRem(x, y, s, true, new(big.Int), new(big.Int))
+}
+
 // quoRem sets z to the quotient x/y with the scale s, and if useRem is true,
 // it sets remNum and remDen to the numerator and denominator of the remainder.
 // It returns z, remNum and remDen.
@@ -296,23 +511,7 @@ func (z *Dec) QuoExact(x, y *Dec) *Dec {
 //
 func (z *Dec) quoRem(x, y *Dec, s Scale, useRem bool,
 	remNum, remDen *big.Int) (*Dec, *big.Int, *big.Int) {
-	// difference (required adjustment) compared to "canonical" result scale
-	shift := s - (x.Scale() - y.Scale())
-	// pointers to adjusted unscaled dividend and divisor
-	var ix, iy *big.Int
-	switch {
-	case shift > 0:
-		// increased scale: decimal-shift dividend left
-		ix = new(big.Int).Mul(x.UnscaledBig(), exp10(shift))
-		iy = y.UnscaledBig()
-	case shift < 0:
-		// decreased scale: decimal-shift divisor left
-		ix = x.UnscaledBig()
-		iy = new(big.Int).Mul(y.UnscaledBig(), exp10(-shift))
-	default:
-		ix = x.UnscaledBig()
-		iy = y.UnscaledBig()
-	}
+	ix, iy := quoOperands(x, y, s)
 	// save a copy of iy in case it to be overwritten with the result
 	iy2 := iy
 	if iy == z.UnscaledBig() {
@@ -333,6 +532,39 @@ func (z *Dec) quoRem(x, y *Dec, s Scale, useRem bool,
 	return z, remNum, remDen
 }
 
+// quoOperands returns the unscaled dividend and divisor adjusted so that
+// their integer quotient is the quotient x/y with the scale s. The results
+// may be the unscaled values of x and y, so they must not be modified.
+func quoOperands(x, y *Dec, s Scale) (ix, iy *big.Int) {
+	// difference (required adjustment) compared to "canonical" result scale
+	shift := checkScale(int64(s) - int64(x.Scale()) + int64(y.Scale()))
+	switch {
+	case shift > 0:
+		// increased scale: decimal-shift dividend left
+		return new(big.Int).Mul(x.UnscaledBig(), exp10(shift)), y.UnscaledBig()
+	case shift < 0:
+		// decreased scale: decimal-shift divisor left
+		return x.UnscaledBig(), new(big.Int).Mul(y.UnscaledBig(), exp10(-shift))
+	}
+	return x.UnscaledBig(), y.UnscaledBig()
+}
+
+// quoHint sets z to the quotient x/y with the scale s, and returns z and the
+// remainder in the form described by the hint h (see RemainderHint).
+func (z *Dec) quoHint(x, y *Dec, s Scale, h RemainderHint) (*Dec, *big.Int, *big.Int) {
+	if h == RemainderFull {
+		return z.quoRem(x, y, s, true, new(big.Int), new(big.Int))
+	}
+	ix, iy := quoOperands(x, y, s)
+	var rem big.Int
+	if iy == z.UnscaledBig() {
+		iy = new(big.Int).Set(iy)
+	}
+	z.UnscaledBig().QuoRem(ix, iy, &rem)
+	rA, rB := hintRemainder(h, &rem, iy)
+	return z.SetScale(s), rA, rB
+}
+
 type sclr struct{ s Scale }
 
 func (s sclr) Scale(x, y *Dec) Scale {
@@ -342,6 +574,9 @@ func (s sclr) Scale(x, y *Dec) Scale {
 type scaleQuoExact struct{}
 
 func (sqe scaleQuoExact) Scale(x, y *Dec) Scale {
+	if y.Sign() == 0 {
+		panic(ErrDivisionByZero)
+	}
 	rem := new(big.Rat).SetFrac(x.UnscaledBig(), y.UnscaledBig())
 	f2, f5 := factor2(rem.Denom()), factor(rem.Denom(), bigInt[5])
 	var f10 Scale
@@ -350,7 +585,7 @@ func (sqe scaleQuoExact) Scale(x, y *Dec) Scale {
 	} else {
 		f10 = Scale(f5)
 	}
-	return x.Scale() - y.Scale() + f10
+	return checkScale(int64(x.Scale()) - int64(y.Scale()) + int64(f10))
 }
 
 func factor(n *big.Int, p *big.Int) int {
@@ -388,15 +623,37 @@ func upscale(a, b *Dec) (*Dec, *Dec) {
 	return aa, b
 }
 
+// validScale reports whether s is in the range of Scale.
+func validScale(s int64) bool {
+	return s >= int64(MinScale) && s <= int64(MaxScale)
+}
+
+// checkScale returns s as a Scale, or panics with ErrScaleOverflow if it is
+// out of the range of Scale.
+func checkScale(s int64) Scale {
+	if !validScale(s) {
+		panic(ErrScaleOverflow)
+	}
+	return Scale(s)
+}
+
+// exp10 returns 10**x, which must not be modified. It panics with
+// ErrScaleOverflow if x is negative, as results from negating MinScale.
 func exp10(x Scale) *big.Int {
-	if int(x) < len(exp10cache) {
+	if uint32(x) < uint32(len(exp10cache)) {
 		return &exp10cache[int(x)]
 	}
+	if x < 0 {
+		panic(ErrScaleOverflow)
+	}
+	if digitLimitExceeded(int64(x)) {
+		panic(ErrDigitLimit)
+	}
 	return new(big.Int).Exp(bigInt[10], big.NewInt(int64(x)), nil)
 }
 
 func (x *Dec) rescale(newScale Scale) *Dec {
-	shift := newScale - x.Scale()
+	shift := checkScale(int64(newScale) - int64(x.Scale()))
 	switch {
 	case shift < 0:
 		e := exp10(-shift)
@@ -412,9 +669,9 @@ var zeros = []byte("00000000000000000000000000000000" +
 	"00000000000000000000000000000000")
 var lzeros = Scale(len(zeros))
 
-func appendZeros(s []byte, n Scale) []byte {
-	for i := Scale(0); i < n; i += lzeros {
-		if n > i+lzeros {
+func appendZeros(s []byte, n int64) []byte {
+	for i := int64(0); i < n; i += int64(lzeros) {
+		if n > i+int64(lzeros) {
 			s = append(s, zeros...)
 		} else {
 			s = append(s, zeros[0:n-i]...)
@@ -431,7 +688,7 @@ func (x *Dec) String() string {
 	s := []byte(x.UnscaledBig().String())
 	if scale <= 0 {
 		if scale != 0 && x.unscaled.Sign() != 0 {
-			s = appendZeros(s, -scale)
+			s = appendZeros(s, -int64(scale))
 		}
 		return string(s)
 	}
@@ -444,7 +701,7 @@ func (x *Dec) String() string {
 			ss = append(ss, '-')
 		}
 		ss = append(ss, '0', '.')
-		ss = appendZeros(ss, scale-lens+negbit)
+		ss = appendZeros(ss, int64(scale-lens+negbit))
 		ss = append(ss, s[negbit:]...)
 		return string(ss)
 	}
@@ -496,6 +753,9 @@ loop:
 			if dg == -1 {
 				dg = len(unscaled)
 			}
+			if digitLimitExceeded(int64(len(unscaled) - dg + 1)) {
+				return nil, ErrDigitLimit
+			}
 		default:
 			r.UnreadRune()
 			break loop
@@ -505,6 +765,9 @@ loop:
 	if dg == -1 {
 		return nil, fmt.Errorf("no digits read")
 	}
+	if dp >= 0 && !validScale(int64(len(unscaled)-dp)) {
+		return nil, ErrScaleOverflow
+	}
 	if dp >= 0 {
 		z.SetScale(Scale(len(unscaled) - dp))
 	} else {
@@ -523,17 +786,24 @@ loop:
 // or 0 if there is no decimal point. If SetString fails, the value of z
 // is undefined but the returned value is nil.
 func (z *Dec) SetString(s string) (*Dec, bool) {
-	r := strings.NewReader(s)
-	_, err := z.scan(r)
-	if err != nil {
+	if z.setString(s) != nil {
 		return nil, false
 	}
-	_, _, err = r.ReadRune()
-	if err != io.EOF {
-		return nil, false
+	return z, true
+}
+
+// setString sets z to the value of s as SetString does, returning the error
+// from scanning s (such as ErrDigitLimit) if it fails.
+func (z *Dec) setString(s string) error {
+	r := strings.NewReader(s)
+	if _, err := z.scan(r); err != nil {
+		return err
+	}
+	if _, _, err := r.ReadRune(); err != io.EOF {
+		return fmt.Errorf("invalid decimal: %s", s)
 	}
 	// err == io.EOF => scan consumed all of s
-	return z, true
+	return nil
 }
 
 // Scan is a support routine for fmt.Scanner; it sets z to the value of
@@ -597,7 +867,7 @@ func (z *Dec) GobDecode(buf []byte) error {
 		return err
 	}
 	z.SetScale(scale(buf[l : l+scaleSize]))
-	return nil
+	return z.checkDigitLimit()
 }
 
 // MarshalText implements the encoding.TextMarshaler interface.
@@ -2,6 +2,7 @@ package inf
 
 import (
 	"math/big"
+	"math/rand"
 	"testing"
 )
 
@@ -38,3 +39,57 @@ func TestDecQuoRem(t *testing.T) {
 		}
 	}
 }
+
+func TestDecRoot(t *testing.T) {
+	// Rounders are initialized in init, so the table can not be a package
+	// level variable
+	var decRootTests = []struct {
+		x   *Dec
+		n   int
+		s   Scale
+		r   Rounder
+		exp *Dec // nil if nil result expected
+	}{
+		{NewDec(2, 0), 2, 6, RoundHalfEven, NewDec(1414214, 6)},
+		{NewDec(27, 0), 3, 2, RoundExact, NewDec(300, 2)},
+		{NewDec(-27, 0), 3, 0, RoundExact, NewDec(-3, 0)},
+		{NewDec(-2, 0), 3, 3, RoundFloor, NewDec(-1260, 3)},
+		{NewDec(-2, 0), 3, 3, RoundCeil, NewDec(-1259, 3)},
+		{NewDec(-4, 0), 2, 0, RoundHalfEven, nil},
+		{NewDec(25, 2), 2, 0, RoundHalfEven, NewDec(0, 0)},
+		{NewDec(25, 2), 2, 0, RoundHalfUp, NewDec(1, 0)},
+		{NewDec(225, 2), 2, 0, RoundHalfEven, NewDec(2, 0)},
+		{NewDec(1, -4), 4, 0, RoundExact, NewDec(10, 0)},
+		{NewDec(1, -4), 4, -1, RoundExact, NewDec(1, -1)},
+		{NewDec(2, 0), 2, 1, RoundExact, nil},
+		{NewDec(5, 0), 1, 1, RoundExact, NewDec(50, 1)},
+		{NewDec(5, 0), 0, 1, RoundExact, nil},
+	}
+	for i, tt := range decRootTests {
+		z := new(Dec).root(tt.x, tt.n, tt.s, tt.r)
+		if tt.exp == nil && z != nil || tt.exp != nil && (z == nil || z.Cmp(tt.exp) != 0 || z.Scale() != tt.s) {
+			t.Errorf("#%d root(%v, %d, %d) got %v; expected %v", i, tt.x, tt.n, tt.s, z, tt.exp)
+		}
+	}
+}
+
+func TestCmpHalf(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		rB := new(big.Int).Rand(r, new(big.Int).Lsh(bigInt[1], uint(1+r.Intn(200))))
+		rB.Add(rB, bigInt[1])
+		// remainders near one half are the interesting cases
+		rA := new(big.Int).Rsh(rB, 1)
+		rA.Add(rA, big.NewInt(int64(r.Intn(3)-1)))
+		if r.Intn(2) == 0 {
+			rA.Neg(rA)
+		}
+		if r.Intn(2) == 0 {
+			rB.Neg(rB)
+		}
+		exp := new(big.Int).Lsh(new(big.Int).Abs(rA), 1).CmpAbs(rB)
+		if c := cmpHalf(rA, rB); c != exp {
+			t.Errorf("#%d cmpHalf(%v, %v) got %d; expected %d", i, rA, rB, c, exp)
+		}
+	}
+}
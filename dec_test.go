@@ -5,6 +5,7 @@ import (
 	"encoding/gob"
 	"fmt"
 	"math/big"
+	"math/rand"
 	"strings"
 	"testing"
 
@@ -128,7 +129,7 @@ var decRoundTests = [...]struct {
 	in  *inf.Dec
 	s   inf.Scale
 	r   inf.Rounder
-	exp *inf.Dec
+	exp *inf.Dec // nil if nil is expected
 }{
 	{inf.NewDec(123424999999999993, 15), 2, inf.RoundHalfUp, inf.NewDec(12342, 2)},
 	{inf.NewDec(123425000000000001, 15), 2, inf.RoundHalfUp, inf.NewDec(12343, 2)},
@@ -140,12 +141,23 @@ var decRoundTests = [...]struct {
 	{inf.NewDecBig(new(big.Int).Lsh(big.NewInt(1), 64), 0), -4, inf.RoundHalfUp, inf.NewDec(1844674407370955, -4)},
 	{inf.NewDecBig(new(big.Int).Lsh(big.NewInt(1), 64), 0), -5, inf.RoundHalfUp, inf.NewDec(184467440737096, -5)},
 	{inf.NewDecBig(new(big.Int).Lsh(big.NewInt(1), 64), 0), -6, inf.RoundHalfUp, inf.NewDec(18446744073710, -6)},
+	{inf.NewDec(-12345, 3), 2, inf.RoundHalfEven, inf.NewDec(-1234, 2)},
+	{inf.NewDec(-12345, 3), 2, inf.RoundFloor, inf.NewDec(-1235, 2)},
+	{inf.NewDec(-12345, 3), 2, inf.RoundCeil, inf.NewDec(-1234, 2)},
+	{inf.NewDec(-12355, 3), 2, inf.RoundHalfEven, inf.NewDec(-1236, 2)},
+	{inf.NewDec(-12345, 3), 0, inf.RoundUp, inf.NewDec(-13, 0)},
+	{inf.NewDec(12300, 3), 1, inf.RoundExact, inf.NewDec(123, 1)},
+	{inf.NewDec(12345, 3), 1, inf.RoundExact, nil},
 }
 
 func TestDecRound(t *testing.T) {
 	for i, tt := range decRoundTests {
 		z := new(inf.Dec).Round(tt.in, tt.s, tt.r)
-		if tt.exp.Cmp(z) != 0 {
+		if tt.exp == nil {
+			if z != nil {
+				t.Errorf("#%d Round got %v; expected nil", i, z)
+			}
+		} else if z == nil || tt.exp.Cmp(z) != 0 || z.Scale() != tt.s {
 			t.Errorf("#%d Round got %v; expected %v", i, z, tt.exp)
 		}
 	}
@@ -377,3 +389,240 @@ func TestDecGobEncoding(t *testing.T) {
 		}
 	}
 }
+
+var decMulQuoTests = []struct {
+	x, y, d string
+	s       inf.Scale
+	r       inf.Rounder
+	exp     string // empty if nil result expected
+}{
+	{"1000.00", "17", "30", 2, inf.RoundHalfEven, "566.67"},
+	{"100.01", "1", "3", 2, inf.RoundHalfUp, "33.34"},
+	{"0.015", "1.5", "1", 2, inf.RoundHalfUp, "0.02"},
+	{"-1000.00", "17", "30", 2, inf.RoundFloor, "-566.67"},
+	{"10", "10", "4", 0, inf.RoundExact, "25"},
+	{"10", "1", "3", 2, inf.RoundExact, ""},
+}
+
+func TestDecMulQuo(t *testing.T) {
+	for i, tt := range decMulQuoTests {
+		x, _ := new(inf.Dec).SetString(tt.x)
+		y, _ := new(inf.Dec).SetString(tt.y)
+		d, _ := new(inf.Dec).SetString(tt.d)
+		z := new(inf.Dec).MulQuo(x, y, d, tt.s, tt.r)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d MulQuo(%v, %v, %v) got %v; expected nil", i, x, y, d, z)
+			}
+			continue
+		}
+		if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d MulQuo(%v, %v, %v) got %v; expected %s", i, x, y, d, z, tt.exp)
+		}
+	}
+}
+
+var decQuoRemTests = []struct {
+	x, y string
+	s    inf.Scale
+	z    string
+	r    *big.Rat
+}{
+	{"2", "3", 0, "0", big.NewRat(2, 3)},
+	{"2", "3", 2, "0.66", big.NewRat(2, 3)},
+	{"-5", "3", 0, "-1", big.NewRat(-2, 3)},
+	{"5", "-3", 1, "-1.6", big.NewRat(-2, 3)},
+	{"-1", "-8", 2, "0.12", big.NewRat(1, 2)},
+	{"1.5", "0.5", 0, "3", big.NewRat(0, 1)},
+	{"125", "1", -2, "100", big.NewRat(1, 4)},
+}
+
+func TestDecQuoRemExported(t *testing.T) {
+	for i, tt := range decQuoRemTests {
+		xs := decs(tt.x, tt.y)
+		z, rA, rB := new(inf.Dec).QuoRem(xs[0], xs[1], tt.s)
+		if z.String() != tt.z || new(big.Rat).SetFrac(rA, rB).Cmp(tt.r) != 0 {
+			t.Errorf("#%d QuoRem(%v, %v, %d) got %v, %v/%v; expected %s, %v",
+				i, xs[0], xs[1], tt.s, z, rA, rB, tt.z, tt.r)
+		}
+	}
+}
+
+func TestDecQuo(t *testing.T) {
+	for i, tt := range []struct {
+		x, y string
+		s    inf.Scaler
+		r    inf.Rounder
+		exp  string // empty if nil is expected
+	}{
+		{"1", "3", inf.ScaleFixed(3), inf.RoundHalfEven, "0.333"},
+		{"2", "3", inf.ScaleFixed(3), inf.RoundDown, "0.666"},
+		{"1", "8", inf.ScaleQuoExact, inf.RoundExact, "0.125"},
+		{"1", "3", inf.ScaleQuoExact, inf.RoundExact, ""},
+		{"1", "0", inf.ScaleFixed(3), inf.RoundHalfEven, ""},
+		{"0", "0.00", inf.ScaleQuoExact, inf.RoundExact, ""},
+	} {
+		xs := decs(tt.x, tt.y)
+		z := new(inf.Dec).Quo(xs[0], xs[1], tt.s, tt.r)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d Quo(%v, %v) got %v; expected nil", i, xs[0], xs[1], z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d Quo(%v, %v) got %v; expected %s", i, xs[0], xs[1], z, tt.exp)
+		}
+	}
+}
+
+func TestDecMulAdd(t *testing.T) {
+	for i, tt := range []struct {
+		x, y, a string
+		s       inf.Scaler
+		r       inf.Rounder
+		exp     string // empty if nil is expected
+	}{
+		{"1.25", "0.035", "10.00", inf.ScaleFixed(2), inf.RoundHalfEven, "10.04"},
+		{"1.25", "0.035", "10.00", inf.ScaleFixed(5), inf.RoundExact, "10.04375"},
+		{"1.25", "0.035", "10.00", inf.ScaleFixed(4), inf.RoundExact, ""},
+		// 0.0049 + 0.0051 is rounded only once
+		{"0.07", "0.07", "0.0051", inf.ScaleFixed(2), inf.RoundHalfUp, "0.01"},
+		{"-2", "0.5", "1", inf.ScaleFixed(0), inf.RoundExact, "0"},
+	} {
+		xs := decs(tt.x, tt.y, tt.a)
+		z := new(inf.Dec).MulAdd(xs[0], xs[1], xs[2], tt.s, tt.r)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d MulAdd(%v, %v, %v) got %v; expected nil", i, xs[0], xs[1], xs[2], z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d MulAdd(%v, %v, %v) got %v; expected %s", i, xs[0], xs[1], xs[2], z, tt.exp)
+		}
+	}
+	// accumulation with aliased arguments
+	z, rate := inf.NewDec(10000, 2), inf.NewDec(5, 2)
+	for i := 0; i < 3; i++ {
+		z.MulAdd(z, rate, z, inf.ScaleFixed(2), inf.RoundHalfEven)
+	}
+	if z.String() != "115.76" {
+		t.Errorf("accumulated MulAdd got %v; expected 115.76", z)
+	}
+}
+
+func TestDecInv(t *testing.T) {
+	for i, tt := range []struct {
+		x   string
+		s   inf.Scaler
+		r   inf.Rounder
+		exp string // empty if nil is expected
+	}{
+		{"3", inf.ScaleFixed(4), inf.RoundHalfEven, "0.3333"},
+		{"-3", inf.ScaleFixed(4), inf.RoundFloor, "-0.3334"},
+		{"8", inf.ScaleQuoExact, inf.RoundExact, "0.125"},
+		{"0.25", inf.ScaleFixed(0), inf.RoundExact, "4"},
+		{"100", inf.ScaleFixed(3), inf.RoundExact, "0.010"},
+		{"-0.0010", inf.ScaleFixed(0), inf.RoundExact, "-1000"},
+		{"1000", inf.ScaleFixed(2), inf.RoundHalfUp, "0.00"},
+		{"1000", inf.ScaleFixed(2), inf.RoundUp, "0.01"},
+		{"1000", inf.ScaleQuoExact, inf.RoundExact, "0.001"},
+		{"3", inf.ScaleQuoExact, inf.RoundExact, ""},
+		{"0", inf.ScaleFixed(2), inf.RoundHalfEven, ""},
+	} {
+		x := decs(tt.x)[0]
+		z := new(inf.Dec).Inv(x, tt.s, tt.r)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d Inv(%v) got %v; expected nil", i, x, z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d Inv(%v) got %v; expected %s", i, x, z, tt.exp)
+		}
+	}
+}
+
+func TestQuoInterval(t *testing.T) {
+	for i, tt := range []struct {
+		x, y   string
+		s      inf.Scaler
+		lo, hi string
+	}{
+		{"1", "3", inf.ScaleFixed(3), "0.333", "0.334"},
+		{"-1", "3", inf.ScaleFixed(3), "-0.334", "-0.333"},
+		{"2", "-3", inf.ScaleFixed(2), "-0.67", "-0.66"},
+		{"1", "8", inf.ScaleFixed(3), "0.125", "0.125"},
+		{"1", "8", inf.ScaleFixed(1), "0.1", "0.2"},
+		{"0", "7", inf.ScaleFixed(1), "0.0", "0.0"},
+	} {
+		xs := decs(tt.x, tt.y)
+		lo, hi := inf.QuoInterval(xs[0], xs[1], tt.s)
+		if lo.String() != tt.lo || hi.String() != tt.hi {
+			t.Errorf("#%d QuoInterval(%v, %v) got %v, %v; expected %s, %s", i, xs[0], xs[1], lo, hi, tt.lo, tt.hi)
+		}
+	}
+}
+
+func TestDecQuoFlag(t *testing.T) {
+	for i, tt := range []struct {
+		x, y    string
+		s       inf.Scaler
+		r       inf.Rounder
+		exp     string // empty if nil is expected
+		inexact bool
+	}{
+		{"1", "4", inf.ScaleFixed(2), inf.RoundHalfEven, "0.25", false},
+		{"1", "4", inf.ScaleFixed(1), inf.RoundHalfEven, "0.2", true},
+		{"1", "3", inf.ScaleFixed(2), inf.RoundDown, "0.33", true},
+		{"-1", "3", inf.ScaleFixed(2), inf.RoundFloor, "-0.34", true},
+		{"6", "3", inf.ScaleFixed(0), inf.RoundDown, "2", false},
+		{"1", "8", inf.ScaleQuoExact, inf.RoundExact, "0.125", false},
+		{"1", "3", inf.ScaleQuoExact, inf.RoundExact, "", true},
+		{"1", "0", inf.ScaleFixed(2), inf.RoundDown, "", false},
+	} {
+		xs := decs(tt.x, tt.y)
+		z, inexact := new(inf.Dec).QuoFlag(xs[0], xs[1], tt.s, tt.r)
+		if inexact != tt.inexact {
+			t.Errorf("#%d QuoFlag(%v, %v) inexact = %v; expected %v", i, xs[0], xs[1], inexact, tt.inexact)
+		}
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d QuoFlag(%v, %v) got %v; expected nil", i, xs[0], xs[1], z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d QuoFlag(%v, %v) got %v; expected %s", i, xs[0], xs[1], z, tt.exp)
+		}
+	}
+}
+
+func TestDecQuoByZero(t *testing.T) {
+	x, zero := inf.NewDec(12, 1), inf.NewDec(0, 2)
+	for _, r := range []inf.Rounder{inf.RoundDown, inf.RoundUp, inf.RoundHalfEven,
+		inf.RoundExact, inf.RoundStochastic(rand.New(rand.NewSource(1)))} {
+		if z := new(inf.Dec).QuoRound(x, zero, 2, r); z != nil {
+			t.Errorf("QuoRound(%v, %v) got %v; expected nil", x, zero, z)
+		}
+	}
+	if z := new(inf.Dec).QuoExact(x, zero); z != nil {
+		t.Errorf("QuoExact(%v, %v) got %v; expected nil", x, zero, z)
+	}
+	if z, rA, rB := new(inf.Dec).QuoRem(x, zero, 2); z != nil || rA != nil || rB != nil {
+		t.Errorf("QuoRem(%v, %v) got %v, %v, %v; expected nils", x, zero, z, rA, rB)
+	}
+	if lo, hi := inf.QuoInterval(x, zero, inf.ScaleFixed(2)); lo != nil || hi != nil {
+		t.Errorf("QuoInterval(%v, %v) got %v, %v; expected nils", x, zero, lo, hi)
+	}
+	if z := new(inf.Dec).MulQuo(x, x, zero, 2, inf.RoundDown); z != nil {
+		t.Errorf("MulQuo(%v, %v, %v) got %v; expected nil", x, x, zero, z)
+	}
+	if _, err := new(inf.Dec).QuoExactErr(x, zero, 2); err != inf.ErrDivisionByZero {
+		t.Errorf("QuoExactErr(%v, %v) got error %v; expected ErrDivisionByZero", x, zero, err)
+	}
+	if p, rep, ok := inf.QuoPeriodic(x, zero); p != nil || rep != "" || ok {
+		t.Errorf("QuoPeriodic(%v, %v) got %v, %q, %v; expected nil", x, zero, p, rep, ok)
+	}
+	if s := inf.QuoPeriodicString(x, zero); s != "<nil>" {
+		t.Errorf("QuoPeriodicString(%v, %v) got %q; expected <nil>", x, zero, s)
+	}
+	expectPanic(t, inf.ErrDivisionByZero, func() { new(inf.Dec).Rem(x, zero) })
+	expectPanic(t, inf.ErrDivisionByZero, func() { new(inf.Dec).Mod(x, zero) })
+	expectPanic(t, inf.ErrDivisionByZero, func() { inf.DivMod(x, zero) })
+	expectPanic(t, inf.ErrDivisionByZero, func() { inf.ScaleQuoExact.Scale(x, zero) })
+}
@@ -0,0 +1,89 @@
+// Package deccash implements cash rounding rules, also known as Swedish
+// rounding: rounding amounts paid in cash to the smallest denomination in
+// circulation, such as 0.05 Swiss francs, as the lower coins of a currency
+// are withdrawn. Rules are available by ISO 4217 currency code.
+//
+// Cash rounding only applies to the total paid in cash; amounts paid by
+// other means are not rounded.
+package deccash // import "gopkg.in/inf.v0/deccash"
+
+import (
+	"strings"
+
+	"gopkg.in/inf.v0"
+)
+
+// A Rule describes the rounding of cash amounts to an integer multiple of a
+// step.
+type Rule struct {
+	// Step is the smallest amount that can be paid in cash. Rounded amounts
+	// have the scale of Step.
+	Step *inf.Dec
+	// Rounder is the rounding rule; ties (amounts halfway between two
+	// multiples of Step) are rounded up (away from zero) in all predefined
+	// rules.
+	Rounder inf.Rounder
+}
+
+// Round returns the amount x rounded according to r. It returns nil if r's
+// Rounder is inf.RoundExact and x is not a multiple of Step.
+func (r Rule) Round(x *inf.Dec) *inf.Dec {
+	return new(inf.Dec).RoundToStep(x, r.Step, r.Rounder)
+}
+
+// Predefined rules, by step.
+var (
+	// Nickel rounds to the nearest 0.05, as in Switzerland, Canada and
+	// Australia.
+	Nickel = Rule{inf.NewDec(5, 2), inf.RoundHalfUp}
+	// Dime rounds to the nearest 0.10, as in New Zealand.
+	Dime = Rule{inf.NewDec(10, 2), inf.RoundHalfUp}
+	// HalfUnit rounds to the nearest 0.50, as in Denmark.
+	HalfUnit = Rule{inf.NewDec(50, 2), inf.RoundHalfUp}
+	// WholeUnit rounds to the nearest 1.00, as in Sweden and Norway.
+	WholeUnit = Rule{inf.NewDec(100, 2), inf.RoundHalfUp}
+)
+
+// currencies holds its own copies of the predefined rules, so that changes
+// to the exported variables or to rules returned by ForCurrency do not
+// affect it.
+var currencies = map[string]Rule{
+	"AUD": Nickel.clone(),
+	"CAD": Nickel.clone(),
+	"CHF": Nickel.clone(),
+	"CZK": WholeUnit.clone(),
+	"DKK": HalfUnit.clone(),
+	"HUF": {inf.NewDec(5, 0), inf.RoundHalfUp},
+	"NOK": WholeUnit.clone(),
+	"NZD": Dime.clone(),
+	"SEK": WholeUnit.clone(),
+}
+
+// clone returns a copy of r that does not share its Step with r.
+func (r Rule) clone() Rule {
+	return Rule{new(inf.Dec).Set(r.Step), r.Rounder}
+}
+
+// ForCurrency returns the cash rounding rule of the currency with the given
+// ISO 4217 code (matched case-insensitively), such as Nickel for "CHF" and
+// WholeUnit for "SEK". The result is false if there is no predefined rule
+// for the currency, such as for currencies without cash rounding. The
+// returned rule's Step is a new value that the caller may modify.
+func ForCurrency(code string) (Rule, bool) {
+	r, ok := currencies[strings.ToUpper(code)]
+	if !ok {
+		return Rule{}, false
+	}
+	return r.clone(), true
+}
+
+// Round returns the amount x in the currency with the given ISO 4217 code,
+// rounded according to the cash rounding rule of the currency (see
+// ForCurrency). It returns x unchanged (not a copy) if there is no rule for
+// the currency.
+func Round(code string, x *inf.Dec) *inf.Dec {
+	if r, ok := currencies[strings.ToUpper(code)]; ok {
+		return r.Round(x)
+	}
+	return x
+}
@@ -0,0 +1,67 @@
+package deccash_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+	"gopkg.in/inf.v0/deccash"
+)
+
+func TestRound(t *testing.T) {
+	for i, tt := range []struct {
+		code, x, exp string
+	}{
+		{"CHF", "12.32", "12.30"},
+		{"CHF", "12.325", "12.35"},
+		{"CHF", "12.38", "12.40"},
+		{"chf", "-12.33", "-12.35"},
+		{"CAD", "1.02", "1.00"},
+		{"CAD", "1.03", "1.05"},
+		{"CAD", "1.07", "1.05"},
+		{"CAD", "1.08", "1.10"},
+		{"NZD", "4.35", "4.40"},
+		{"DKK", "9.74", "9.50"},
+		{"DKK", "9.75", "10.00"},
+		{"SEK", "99.49", "99.00"},
+		{"SEK", "99.50", "100.00"},
+		{"HUF", "1232", "1230"},
+		{"HUF", "1233", "1235"},
+		{"EUR", "12.33", "12.33"},
+		{"", "1.234", "1.234"},
+	} {
+		x, _ := new(inf.Dec).SetString(tt.x)
+		if z := deccash.Round(tt.code, x); z == nil || z.String() != tt.exp {
+			t.Errorf("#%d Round(%q, %v) got %v; expected %s", i, tt.code, x, z, tt.exp)
+		}
+	}
+}
+
+func TestForCurrency(t *testing.T) {
+	if r, ok := deccash.ForCurrency("sek"); !ok || r.Step.Cmp(deccash.WholeUnit.Step) != 0 {
+		t.Errorf(`ForCurrency("sek") got %v, %v`, r, ok)
+	}
+	if _, ok := deccash.ForCurrency("USD"); ok {
+		t.Errorf(`ForCurrency("USD") got ok`)
+	}
+	exact := deccash.Rule{Step: inf.NewDec(5, 2), Rounder: inf.RoundExact}
+	if z := exact.Round(inf.NewDec(1234, 2)); z != nil {
+		t.Errorf("Round(12.34) with RoundExact got %v; expected nil", z)
+	}
+}
+
+func TestForCurrencyNoAliasing(t *testing.T) {
+	r, _ := deccash.ForCurrency("CHF")
+	r.Step.SetUnscaled(1)
+	if deccash.Nickel.Step.Cmp(inf.NewDec(5, 2)) != 0 {
+		t.Errorf("modifying ForCurrency result changed Nickel.Step to %v", deccash.Nickel.Step)
+	}
+	saved := new(inf.Dec).Set(deccash.Nickel.Step)
+	deccash.Nickel.Step.SetUnscaled(1)
+	defer deccash.Nickel.Step.Set(saved)
+	if z := deccash.Round("CHF", inf.NewDec(1234, 2)); z.String() != "12.35" {
+		t.Errorf("modifying Nickel.Step changed Round(\"CHF\", 12.34) to %v", z)
+	}
+	if r, _ := deccash.ForCurrency("CHF"); r.Step.Cmp(saved) != 0 {
+		t.Errorf("modifying Nickel.Step changed ForCurrency(\"CHF\").Step to %v", r.Step)
+	}
+}
@@ -0,0 +1,265 @@
+// Package decfmt implements conversions of inf.Dec values to and from their
+// string representations, in the shape of the strconv package.
+//
+// The formatting rules are independent of package fmt and of the String and
+// Format methods of inf.Dec, and are stable across releases. Rounding, where
+// needed, is always to nearest, with ties rounded to even (as by
+// inf.RoundHalfEven).
+package decfmt // import "gopkg.in/inf.v0/decfmt"
+
+import (
+	"strconv"
+
+	"gopkg.in/inf.v0"
+)
+
+// ParseDec converts the string s to a Dec. It accepts an optional sign, a
+// non-empty sequence of digits with an optional decimal point, and an
+// optional exponent consisting of 'e' or 'E', an optional sign and decimal
+// digits; that is, any output of FormatDec. The scale of the result is the
+// number of digits after the decimal point minus the exponent, so that
+// ParseDec("1.50") has scale 2 and ParseDec("1.5e+3") has scale -2.
+//
+// The errors that ParseDec returns have concrete type *strconv.NumError with
+// Func "ParseDec". If s is syntactically invalid, the error has Err set to
+// strconv.ErrSyntax; if the scale of the result is out of the range of
+// inf.Scale, it has Err set to strconv.ErrRange. If the digits or the scale
+// of the result exceed inf.MaxDigits, it has Err set to inf.ErrDigitLimit.
+func ParseDec(s string) (*inf.Dec, error) {
+	mant, exp := s, int64(0)
+	for i := 0; i < len(s); i++ {
+		if s[i] == 'e' || s[i] == 'E' {
+			e, err := strconv.ParseInt(s[i+1:], 10, 32)
+			if err != nil {
+				if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+					return nil, rangeError(s)
+				}
+				return nil, syntaxError(s)
+			}
+			mant, exp = s[:i], e
+			break
+		}
+	}
+	z, err := inf.ParseDec(mant)
+	if err == inf.ErrDigitLimit {
+		return nil, digitLimitError(s)
+	} else if err != nil {
+		return nil, syntaxError(s)
+	}
+	scale := int64(z.Scale()) - exp
+	if scale < int64(inf.MinScale) || scale > int64(inf.MaxScale) {
+		return nil, rangeError(s)
+	}
+	if inf.MaxDigits > 0 && (scale > int64(inf.MaxDigits) || -scale > int64(inf.MaxDigits)) {
+		return nil, digitLimitError(s)
+	}
+	return z.SetScale(inf.Scale(scale)), nil
+}
+
+func syntaxError(s string) error {
+	return &strconv.NumError{Func: "ParseDec", Num: s, Err: strconv.ErrSyntax}
+}
+
+func rangeError(s string) error {
+	return &strconv.NumError{Func: "ParseDec", Num: s, Err: strconv.ErrRange}
+}
+
+func digitLimitError(s string) error {
+	return &strconv.NumError{Func: "ParseDec", Num: s, Err: inf.ErrDigitLimit}
+}
+
+// FormatDec converts x to a string, according to the format and precision
+// prec. The format is one of
+//
+//	'f' (-ddd.dddd, no exponent),
+//	'e' (-d.dddde±dd, a decimal exponent),
+//	'E' (-d.ddddE±dd, a decimal exponent),
+//	'g' ('e' for negative scales or small exponents, 'f' otherwise),
+//	'G' ('E' for negative scales or small exponents, 'f' otherwise).
+//
+// The precision prec controls the number of digits. For 'e', 'E' and 'f' it
+// is the number of digits after the decimal point; for 'g' and 'G' it is the
+// maximum number of significant digits (a prec of 0 is treated as 1). The
+// special precision -1 uses the digits of x as they are, so that the scale
+// of x is retained (except for negative scales in 'f' format):
+//
+//	x        'f', -1  'e', -1   'g', -1  'f', 1  'e', 1    'g', 1
+//	1.50     1.50     1.50e+00  1.50     1.5     1.5e+00   2
+//	1234     1234     1.234e+03 1234     1234.0  1.2e+03   1e+03
+//	1.2E+2   120      1.2e+02   1.2e+02  120.0   1.2e+02   1e+02
+//	0.00012  0.00012  1.2e-04   0.00012  0.0     1.2e-04   0.0001
+//
+// For 'g' and 'G', the result (after rounding to prec significant digits) is
+// formatted as by 'f' if its scale is not negative and its exponent in 'e'
+// format is at least -6, and as by 'e' or 'E' otherwise (as in the
+// to-scientific-string conversion of the General Decimal Arithmetic
+// Specification). Exponents have at least two digits. Zero results are
+// never negative. An invalid format results in "%" followed by the format
+// character.
+func FormatDec(x *inf.Dec, format byte, prec int) string {
+	return string(AppendDec(make([]byte, 0, 24), x, format, prec))
+}
+
+// AppendDec appends the string form of x, as generated by FormatDec, to dst
+// and returns the extended buffer.
+func AppendDec(dst []byte, x *inf.Dec, format byte, prec int) []byte {
+	var buf [40]byte
+	d := x.UnscaledBig().Append(buf[:0], 10)
+	neg := d[0] == '-'
+	if neg {
+		d = d[1:]
+	}
+	scale := int(x.Scale())
+	switch format {
+	case 'f':
+		if prec >= 0 {
+			d, scale = round(d, scale, prec)
+		}
+		return fmtF(dst, neg, d, scale)
+	case 'e', 'E':
+		if prec >= 0 {
+			d, scale = roundDigits(d, scale, prec+1)
+		}
+		return fmtE(dst, neg, d, scale, prec, format)
+	case 'g', 'G':
+		if prec >= 0 {
+			if prec == 0 {
+				prec = 1
+			}
+			if len(d) > prec {
+				d, scale = roundDigits(d, scale, prec)
+			}
+		}
+		if scale >= 0 && len(d)-1-scale >= -6 {
+			return fmtF(dst, neg, d, scale)
+		}
+		return fmtE(dst, neg, d, scale, -1, format-'g'+'e')
+	}
+	return append(dst, '%', format)
+}
+
+// round rounds the decimal with the digits d and scale to newScale, and
+// returns the resulting digits and scale. It may modify d.
+func round(d []byte, scale, newScale int) ([]byte, int) {
+	if newScale >= scale {
+		if len(d) == 1 && d[0] == '0' {
+			return d, newScale
+		}
+		for i := scale; i < newScale; i++ {
+			d = append(d, '0')
+		}
+		return d, newScale
+	}
+	n := len(d) - (scale - newScale) // digits kept
+	if n < 0 {
+		return append(d[:0], '0'), newScale
+	}
+	up := false
+	if n < len(d) {
+		switch r := d[n]; {
+		case r > '5':
+			up = true
+		case r == '5':
+			up = n > 0 && (d[n-1]-'0')%2 == 1
+			for _, ch := range d[n+1:] {
+				if ch != '0' {
+					up = true
+					break
+				}
+			}
+		}
+	}
+	d = d[:n]
+	if !up {
+		if n == 0 {
+			d = append(d, '0')
+		}
+		return d, newScale
+	}
+	for i := n - 1; i >= 0; i-- {
+		if d[i] < '9' {
+			d[i]++
+			return d, newScale
+		}
+		d[i] = '0'
+	}
+	return append([]byte{'1'}, d...), newScale
+}
+
+// roundDigits rounds the decimal with the digits d and scale to n
+// significant digits (n >= 1), and returns the resulting digits and scale. It
+// may modify d.
+func roundDigits(d []byte, scale, n int) ([]byte, int) {
+	if len(d) == 1 && d[0] == '0' {
+		return round(d, scale, scale+n-1)
+	}
+	d, scale = round(d, scale, scale-len(d)+n)
+	if len(d) > n {
+		// carry into a new digit; the last digit is 0
+		d, scale = d[:n], scale-1
+	}
+	return d, scale
+}
+
+func fmtF(dst []byte, neg bool, d []byte, scale int) []byte {
+	zero := len(d) == 1 && d[0] == '0'
+	if neg && !zero {
+		dst = append(dst, '-')
+	}
+	switch {
+	case zero && scale < 0:
+		// no trailing zeros for the integer zero
+		dst = append(dst, '0')
+	case scale <= 0:
+		dst = append(dst, d...)
+		for i := scale; i < 0; i++ {
+			dst = append(dst, '0')
+		}
+	case len(d) <= scale:
+		dst = append(dst, '0', '.')
+		for i := len(d); i < scale; i++ {
+			dst = append(dst, '0')
+		}
+		dst = append(dst, d...)
+	default:
+		dst = append(dst, d[:len(d)-scale]...)
+		dst = append(dst, '.')
+		dst = append(dst, d[len(d)-scale:]...)
+	}
+	return dst
+}
+
+// fmtE formats the decimal with the digits d and scale in 'e' (or 'E')
+// format. For zero values, prec (if not -1) is the number of zero digits after
+// the decimal point, and the exponent is 0; otherwise the digits are given by
+// d.
+func fmtE(dst []byte, neg bool, d []byte, scale int, prec int, e byte) []byte {
+	exp := len(d) - 1 - scale
+	zero := len(d) == 1 && d[0] == '0'
+	if zero && prec >= 0 {
+		exp = 0
+	} else if neg {
+		dst = append(dst, '-')
+	}
+	dst = append(dst, d[0])
+	if zero && prec > 0 {
+		dst = append(dst, '.')
+		for i := 0; i < prec; i++ {
+			dst = append(dst, '0')
+		}
+	} else if len(d) > 1 {
+		dst = append(dst, '.')
+		dst = append(dst, d[1:]...)
+	}
+	dst = append(dst, e)
+	if exp < 0 {
+		dst = append(dst, '-')
+		exp = -exp
+	} else {
+		dst = append(dst, '+')
+	}
+	if exp < 10 {
+		dst = append(dst, '0')
+	}
+	return strconv.AppendInt(dst, int64(exp), 10)
+}
@@ -0,0 +1,155 @@
+package decfmt_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"gopkg.in/inf.v0"
+	"gopkg.in/inf.v0/decfmt"
+)
+
+var formatTests = []struct {
+	x      *inf.Dec
+	format byte
+	prec   int
+	out    string
+}{
+	{inf.NewDec(150, 2), 'f', -1, "1.50"},
+	{inf.NewDec(150, 2), 'e', -1, "1.50e+00"},
+	{inf.NewDec(150, 2), 'g', -1, "1.50"},
+	{inf.NewDec(150, 2), 'f', 1, "1.5"},
+	{inf.NewDec(150, 2), 'e', 1, "1.5e+00"},
+	{inf.NewDec(150, 2), 'g', 1, "2"},
+	{inf.NewDec(1234, 0), 'f', -1, "1234"},
+	{inf.NewDec(1234, 0), 'e', -1, "1.234e+03"},
+	{inf.NewDec(1234, 0), 'g', -1, "1234"},
+	{inf.NewDec(1234, 0), 'f', 1, "1234.0"},
+	{inf.NewDec(1234, 0), 'e', 1, "1.2e+03"},
+	{inf.NewDec(1234, 0), 'g', 1, "1e+03"},
+	{inf.NewDec(12, -1), 'f', -1, "120"},
+	{inf.NewDec(12, -1), 'e', -1, "1.2e+02"},
+	{inf.NewDec(12, -1), 'G', -1, "1.2E+02"},
+	{inf.NewDec(12, -1), 'f', 1, "120.0"},
+	{inf.NewDec(12, -1), 'E', 1, "1.2E+02"},
+	{inf.NewDec(12, -1), 'g', 1, "1e+02"},
+	{inf.NewDec(12, 5), 'f', -1, "0.00012"},
+	{inf.NewDec(12, 5), 'e', -1, "1.2e-04"},
+	{inf.NewDec(12, 5), 'g', -1, "0.00012"},
+	{inf.NewDec(12, 5), 'f', 1, "0.0"},
+	{inf.NewDec(12, 5), 'e', 1, "1.2e-04"},
+	{inf.NewDec(12, 5), 'g', 1, "0.0001"},
+	{inf.NewDec(12, 9), 'g', -1, "1.2e-08"},
+	// rounding
+	{inf.NewDec(-25, 1), 'f', 0, "-2"},
+	{inf.NewDec(-35, 1), 'f', 0, "-4"},
+	{inf.NewDec(2501, 3), 'f', 0, "3"},
+	{inf.NewDec(5, 1), 'f', 0, "0"},
+	{inf.NewDec(51, 2), 'f', 0, "1"},
+	{inf.NewDec(-1, 3), 'f', 2, "0.00"},
+	{inf.NewDec(9999, 3), 'f', 2, "10.00"},
+	{inf.NewDec(9999, 3), 'e', 2, "1.00e+01"},
+	{inf.NewDec(9999, 3), 'g', 3, "10.0"},
+	{inf.NewDec(-99999, 0), 'g', 2, "-1.0e+05"},
+	{inf.NewDec(123456789, 0), 'e', 20, "1.23456789000000000000e+08"},
+	// zero
+	{inf.NewDec(0, 0), 'f', -1, "0"},
+	{inf.NewDec(0, 2), 'f', -1, "0.00"},
+	{inf.NewDec(0, -2), 'f', -1, "0"},
+	{inf.NewDec(0, -2), 'f', 1, "0.0"},
+	{inf.NewDec(0, -2), 'g', -1, "0e+02"},
+	{inf.NewDec(0, 2), 'e', -1, "0e-02"},
+	{inf.NewDec(0, 2), 'e', 3, "0.000e+00"},
+	{inf.NewDec(0, -2), 'g', -1, "0e+02"},
+	{inf.NewDec(0, 2), 'g', 3, "0.00"},
+	// large exponents
+	{inf.NewDec(1, -123), 'e', -1, "1e+123"},
+	{inf.NewDec(-1, 123), 'E', 0, "-1E-123"},
+	// invalid format
+	{inf.NewDec(1, 0), 'x', -1, "%x"},
+}
+
+func TestFormatDec(t *testing.T) {
+	for i, tt := range formatTests {
+		if s := decfmt.FormatDec(tt.x, tt.format, tt.prec); s != tt.out {
+			t.Errorf("#%d FormatDec(%v, %c, %d) got %q; expected %q",
+				i, tt.x, tt.format, tt.prec, s, tt.out)
+		}
+		if b := decfmt.AppendDec([]byte("x="), tt.x, tt.format, tt.prec); string(b) != "x="+tt.out {
+			t.Errorf("#%d AppendDec(%v, %c, %d) got %q; expected %q",
+				i, tt.x, tt.format, tt.prec, b, "x="+tt.out)
+		}
+	}
+}
+
+func TestFormatDecUnchanged(t *testing.T) {
+	x := inf.NewDec(9999, 3)
+	decfmt.FormatDec(x, 'f', 0)
+	if x.Cmp(inf.NewDec(9999, 3)) != 0 || x.Scale() != 3 {
+		t.Errorf("FormatDec modified its argument: %v", x)
+	}
+}
+
+var parseTests = []struct {
+	in  string
+	x   *inf.Dec
+	err error
+}{
+	{"1.50", inf.NewDec(150, 2), nil},
+	{"-1.50", inf.NewDec(-150, 2), nil},
+	{"+12", inf.NewDec(12, 0), nil},
+	{"1.5e+3", inf.NewDec(15, -2), nil},
+	{"1.5E3", inf.NewDec(15, -2), nil},
+	{"1.5e-3", inf.NewDec(15, 4), nil},
+	{"0e-02", inf.NewDec(0, 2), nil},
+	{"", nil, strconv.ErrSyntax},
+	{"1.5e", nil, strconv.ErrSyntax},
+	{"e5", nil, strconv.ErrSyntax},
+	{"1.5e+-3", nil, strconv.ErrSyntax},
+	{"1.5x", nil, strconv.ErrSyntax},
+	{"1e3000000000", nil, strconv.ErrRange},
+	{"1e-2147483648", nil, strconv.ErrRange},
+	{"0.1e-2147483647", nil, strconv.ErrRange},
+}
+
+func TestParseDec(t *testing.T) {
+	for i, tt := range parseTests {
+		x, err := decfmt.ParseDec(tt.in)
+		if tt.err != nil {
+			ne, ok := err.(*strconv.NumError)
+			if x != nil || !ok || ne.Err != tt.err || ne.Func != "ParseDec" || ne.Num != tt.in {
+				t.Errorf("#%d ParseDec(%q) got %v, %v; expected error %v", i, tt.in, x, err, tt.err)
+			}
+			continue
+		}
+		if err != nil || x.Cmp(tt.x) != 0 || x.Scale() != tt.x.Scale() {
+			t.Errorf("#%d ParseDec(%q) got %v, %v; expected %v", i, tt.in, x, err, tt.x)
+		}
+	}
+}
+
+func TestParseDecRoundTrip(t *testing.T) {
+	for i, tt := range formatTests {
+		if tt.prec != -1 || tt.format == 'x' || tt.format == 'f' && tt.x.Scale() < 0 {
+			continue // 'f' does not retain negative scales
+		}
+		x, err := decfmt.ParseDec(decfmt.FormatDec(tt.x, tt.format, -1))
+		if err != nil || x.Cmp(tt.x) != 0 || x.Scale() != tt.x.Scale() {
+			t.Errorf("#%d round trip of %v with %c got %v, %v", i, tt.x, tt.format, x, err)
+		}
+	}
+}
+
+func TestParseDecMaxDigits(t *testing.T) {
+	defer func(n int) { inf.MaxDigits = n }(inf.MaxDigits)
+	inf.MaxDigits = 1000
+	for _, s := range []string{"1e50000000", "1e-1001", "0e1001", "1" + strings.Repeat("0", 1000)} {
+		x, err := decfmt.ParseDec(s)
+		if ne, ok := err.(*strconv.NumError); x != nil || !ok || ne.Err != inf.ErrDigitLimit {
+			t.Errorf("ParseDec(%.20q) got %v, %v; expected ErrDigitLimit", s, x, err)
+		}
+	}
+	if x, err := decfmt.ParseDec("1e1000"); err != nil || x.Scale() != -1000 {
+		t.Errorf("ParseDec(1e1000) got %v, %v; expected scale -1000", x, err)
+	}
+}
@@ -0,0 +1,92 @@
+// Package dectest provides helpers for testing code that uses inf.Dec values.
+//
+// Failure messages describe each value along with its unscaled value and
+// scale, so that values that are mathematically equal but differ in scale
+// (such as 1 and 1.0) are distinguishable in test output.
+package dectest // import "gopkg.in/inf.v0/dectest"
+
+import (
+	"fmt"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+// Describe returns a description of x including its representation, such as
+// "1.50 (unscaled 150, scale 2)", or "<nil>" if x is nil.
+func Describe(x *inf.Dec) string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s (unscaled %s, scale %d)", x, x.UnscaledBig(), x.Scale())
+}
+
+// MustParse returns the Dec value of s, as parsed by SetString. It panics if
+// s is not a valid decimal. It is intended for use in test tables.
+func MustParse(s string) *inf.Dec {
+	x, ok := new(inf.Dec).SetString(s)
+	if !ok {
+		panic(fmt.Sprintf("dectest: invalid decimal %q", s))
+	}
+	return x
+}
+
+// bothNil reports whether want and got are both nil, and reports an error
+// through t if exactly one of them is nil. ok is false in the latter case.
+func bothNil(t testing.TB, want, got *inf.Dec) (both, ok bool) {
+	if want == nil || got == nil {
+		if want != got {
+			t.Helper()
+			t.Errorf("got %s; want %s", Describe(got), Describe(want))
+			return false, false
+		}
+		return true, true
+	}
+	return false, true
+}
+
+// AssertEqual reports an error through t unless got has the same value and
+// the same scale as want (or both are nil). It returns whether the assertion
+// held.
+func AssertEqual(t testing.TB, want, got *inf.Dec) bool {
+	t.Helper()
+	if both, ok := bothNil(t, want, got); both || !ok {
+		return ok
+	}
+	if got.Cmp(want) != 0 || got.Scale() != want.Scale() {
+		t.Errorf("got %s; want %s", Describe(got), Describe(want))
+		return false
+	}
+	return true
+}
+
+// AssertEqualValue reports an error through t unless got has the same
+// mathematical value as want (or both are nil), regardless of scale. It
+// returns whether the assertion held.
+func AssertEqualValue(t testing.TB, want, got *inf.Dec) bool {
+	t.Helper()
+	if both, ok := bothNil(t, want, got); both || !ok {
+		return ok
+	}
+	if got.Cmp(want) != 0 {
+		t.Errorf("got %s; want value %s", Describe(got), Describe(want))
+		return false
+	}
+	return true
+}
+
+// AssertWithin reports an error through t unless |want-got| <= eps (or both
+// want and got are nil). It returns whether the assertion held.
+func AssertWithin(t testing.TB, want, got, eps *inf.Dec) bool {
+	t.Helper()
+	if both, ok := bothNil(t, want, got); both || !ok {
+		return ok
+	}
+	d := new(inf.Dec).Sub(got, want)
+	if d.Abs(d).Cmp(eps) > 0 {
+		t.Errorf("got %s; want %s within %s (difference %s)",
+			Describe(got), Describe(want), eps, d)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package dectest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"gopkg.in/inf.v0"
+	"gopkg.in/inf.v0/dectest"
+)
+
+// recorder records the errors reported through it.
+type recorder struct {
+	testing.TB
+	errs []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errs = append(r.errs, fmt.Sprintf(format, args...))
+}
+
+var p = dectest.MustParse
+
+var dectestTests = []struct {
+	name      string
+	assert    func(t testing.TB) bool
+	errorText string // empty if the assertion is expected to hold
+}{
+	{"Equal", func(t testing.TB) bool { return dectest.AssertEqual(t, p("1.50"), p("1.50")) }, ""},
+	{"EqualNil", func(t testing.TB) bool { return dectest.AssertEqual(t, nil, nil) }, ""},
+	{"EqualScale", func(t testing.TB) bool { return dectest.AssertEqual(t, p("1"), p("1.0")) },
+		"got 1.0 (unscaled 10, scale 1); want 1 (unscaled 1, scale 0)"},
+	{"EqualNilGot", func(t testing.TB) bool { return dectest.AssertEqual(t, p("1"), nil) },
+		"got <nil>; want 1 (unscaled 1, scale 0)"},
+	{"EqualValue", func(t testing.TB) bool { return dectest.AssertEqualValue(t, p("1"), p("1.0")) }, ""},
+	{"EqualValueDiffers", func(t testing.TB) bool { return dectest.AssertEqualValue(t, p("1"), p("1.1")) },
+		"got 1.1 (unscaled 11, scale 1); want value 1 (unscaled 1, scale 0)"},
+	{"Within", func(t testing.TB) bool { return dectest.AssertWithin(t, p("1"), p("1.004"), p("0.005")) }, ""},
+	{"WithinBound", func(t testing.TB) bool { return dectest.AssertWithin(t, p("1"), p("0.995"), p("0.005")) }, ""},
+	{"WithinExceeded", func(t testing.TB) bool { return dectest.AssertWithin(t, p("1"), p("1.006"), p("0.005")) },
+		"got 1.006 (unscaled 1006, scale 3); want 1 (unscaled 1, scale 0) within 0.005 (difference 0.006)"},
+}
+
+func TestAssertions(t *testing.T) {
+	for _, tt := range dectestTests {
+		r := &recorder{TB: t}
+		ok := tt.assert(r)
+		if ok != (tt.errorText == "") {
+			t.Errorf("%s: got %v; expected %v", tt.name, ok, !ok)
+		}
+		if tt.errorText == "" && len(r.errs) != 0 || tt.errorText != "" && (len(r.errs) != 1 || r.errs[0] != tt.errorText) {
+			t.Errorf("%s: got errors %q; expected %q", tt.name, r.errs, tt.errorText)
+		}
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	if x := dectest.MustParse("-0.10"); x.Cmp(inf.NewDec(-10, 2)) != 0 || x.Scale() != 2 {
+		t.Errorf("MustParse got %s", dectest.Describe(x))
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParse of invalid input did not panic")
+		}
+	}()
+	dectest.MustParse("x")
+}
@@ -0,0 +1,35 @@
+package inf
+
+// A Denomination is a named unit of an amount, equal to 10**Exp base units;
+// for example, an ether is 10**18 wei.
+type Denomination struct {
+	Name string
+	Exp  Scale
+}
+
+// Common cryptocurrency denominations.
+var (
+	Wei   = Denomination{"wei", 0}
+	Gwei  = Denomination{"gwei", 9}
+	Ether = Denomination{"ether", 18}
+
+	Satoshi = Denomination{"satoshi", 0}
+	Bitcoin = Denomination{"bitcoin", 8}
+)
+
+// ConvertUnits sets z to the amount x in units of from, converted to units of
+// to and rounded using the given Rounder to the specified scale, and returns
+// z. The conversion itself only shifts the decimal point, so it is exact;
+// rounding applies when the result has more fractional digits than the
+// scale, such as when converting to whole base units with scale 0.
+//
+// If the rounder is RoundExact but the result can not be expressed exactly at
+// the specified scale, ConvertUnits returns nil, and the value of z is
+// undefined.
+func (z *Dec) ConvertUnits(x *Dec, from, to Denomination, s Scale, r Rounder) *Dec {
+	v := new(Dec).movePoint(x, from.Exp-to.Exp)
+	if v.Scale() == s {
+		return z.Set(v)
+	}
+	return z.Round(v, s, r)
+}
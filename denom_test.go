@@ -0,0 +1,43 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decConvertUnitsTests = []struct {
+	x        string
+	from, to inf.Denomination
+	s        inf.Scale
+	r        inf.Rounder
+	exp      string // empty if nil result expected
+}{
+	{"1", inf.Ether, inf.Wei, 0, inf.RoundExact, "1000000000000000000"},
+	{"1.5", inf.Ether, inf.Gwei, 0, inf.RoundExact, "1500000000"},
+	{"21", inf.Gwei, inf.Ether, 18, inf.RoundExact, "0.000000021000000000"},
+	{"1", inf.Wei, inf.Ether, 18, inf.RoundExact, "0.000000000000000001"},
+	{"1", inf.Wei, inf.Ether, 9, inf.RoundExact, ""},
+	{"1", inf.Wei, inf.Ether, 9, inf.RoundUp, "0.000000001"},
+	{"0.123456789", inf.Bitcoin, inf.Satoshi, 0, inf.RoundExact, ""},
+	{"0.123456789", inf.Bitcoin, inf.Satoshi, 0, inf.RoundHalfEven, "12345679"},
+	{"-0.123456785", inf.Bitcoin, inf.Satoshi, 0, inf.RoundHalfEven, "-12345678"},
+	{"12345678", inf.Satoshi, inf.Bitcoin, 8, inf.RoundExact, "0.12345678"},
+	{"100", inf.Satoshi, inf.Bitcoin, 2, inf.RoundDown, "0.00"},
+}
+
+func TestDecConvertUnits(t *testing.T) {
+	for i, tt := range decConvertUnitsTests {
+		x, _ := new(inf.Dec).SetString(tt.x)
+		z := new(inf.Dec).ConvertUnits(x, tt.from, tt.to, tt.s, tt.r)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d ConvertUnits(%v %s to %s) got %v; expected nil", i, x, tt.from.Name, tt.to.Name, z)
+			}
+			continue
+		}
+		if z == nil || z.String() != tt.exp || z.Scale() != tt.s {
+			t.Errorf("#%d ConvertUnits(%v %s to %s) got %v; expected %s", i, x, tt.from.Name, tt.to.Name, z, tt.exp)
+		}
+	}
+}
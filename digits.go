@@ -0,0 +1,93 @@
+package inf
+
+import (
+	"math"
+	"math/big"
+)
+
+// numDigits returns the number of decimal digits in the absolute value of u,
+// or 0 if u is zero.
+func numDigits(u *big.Int) int {
+	b := u.BitLen()
+	if b == 0 {
+		return 0
+	}
+	// 2**(b-1) <= |u| < 2**b, so n is either the exact digit count or one less
+	n := int(float64(b-1)*math.Log10(2)) + 1
+	// 10**19 overflows int64, so the fast path is limited to 10**18
+	if n <= 18 && u.IsInt64() {
+		if v := u.Int64(); v >= exp10cache[n].Int64() || v <= -exp10cache[n].Int64() {
+			n++
+		}
+		return n
+	}
+	if new(big.Int).Abs(u).Cmp(exp10(checkScale(int64(n)))) >= 0 {
+		n++
+	}
+	return n
+}
+
+// FitsScale reports whether the value of x can be represented with scale s
+// without rounding.
+func (x *Dec) FitsScale(s Scale) bool {
+	if s >= x.Scale() || x.Sign() == 0 {
+		return true
+	}
+	_, rs := x.reduced()
+	return rs <= s
+}
+
+// FitsPrecision reports whether the value of x can be represented with at
+// most p significant digits without rounding; that is, whether the unscaled
+// value of x, with trailing zeros removed, has at most p digits. Zero fits any
+// precision.
+func (x *Dec) FitsPrecision(p int) bool {
+	u, _ := x.reduced()
+	return numDigits(u) <= p
+}
+
+// IntDigits returns the number of digits in the integer part of x, or 0 if
+// |x| < 1. (Note that String formats the integer part of such values as a
+// single "0".)
+func (x *Dec) IntDigits() int {
+	n := numDigits(x.UnscaledBig())
+	if n == 0 {
+		return 0
+	}
+	n -= int(x.Scale())
+	if n < 0 {
+		return 0
+	}
+	return n
+}
+
+// FracDigits returns the number of digits after the decimal point in the
+// representation of x; that is, its scale, or 0 if the scale is negative.
+// Trailing zeros are included; see MinFracDigits.
+func (x *Dec) FracDigits() int {
+	if s := x.Scale(); s > 0 {
+		return int(s)
+	}
+	return 0
+}
+
+// MinFracDigits returns the least number of digits after the decimal point
+// that are needed to represent the value of x; that is, FracDigits with
+// trailing zeros excluded.
+func (x *Dec) MinFracDigits() int {
+	if _, s := x.reduced(); s > 0 {
+		return int(s)
+	}
+	return 0
+}
+
+// Precision returns the number of decimal digits in the unscaled value of x,
+// including trailing zeros (such as 3 for both 1.20 and 120), or 1 if x is
+// zero. The digit count is obtained from the bit length of the unscaled
+// value, with at most one comparison for correction.
+func (x *Dec) Precision() int {
+	if n := numDigits(x.UnscaledBig()); n > 0 {
+		return n
+	}
+	return 1
+}
@@ -0,0 +1,109 @@
+package inf_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decFitsTests = []struct {
+	in    string
+	s     inf.Scale
+	fitsS bool
+	p     int
+	fitsP bool
+}{
+	{"0", -5, true, 0, true},
+	{"0.000", 0, true, 1, true},
+	{"1", 0, true, 1, true},
+	{"1.50", 1, true, 1, false},
+	{"1.50", 0, false, 2, true},
+	{"1.55", 1, false, 3, true},
+	{"-123.4500", 2, true, 4, false},
+	{"-123.4500", 2, true, 5, true},
+	{"1000", -3, true, 1, true},
+	{"1000", -4, false, 0, false},
+	{"9999999999999999999", 0, true, 18, false},
+	{"9999999999999999999", 0, true, 19, true},
+	{"1000000000000000000", 0, true, 1, true},
+	{"4611686018427387904", 0, true, 19, true},
+	{"9223372036854775807", 0, true, 18, false},
+	{"9223372036854775807", 0, true, 19, true},
+	{"10000000000000000000", -19, true, 1, true},
+	{"10000000000000000000", -20, false, 1, true},
+	{"12345678901234567890123456789", 0, true, 28, false},
+	{"12345678901234567890123456789", 0, true, 29, true},
+}
+
+func TestDecFits(t *testing.T) {
+	for i, tt := range decFitsTests {
+		x, _ := new(inf.Dec).SetString(tt.in)
+		if f := x.FitsScale(tt.s); f != tt.fitsS {
+			t.Errorf("#%d %v FitsScale(%d) got %v; expected %v", i, x, tt.s, f, tt.fitsS)
+		}
+		if f := x.FitsPrecision(tt.p); f != tt.fitsP {
+			t.Errorf("#%d %v FitsPrecision(%d) got %v; expected %v", i, x, tt.p, f, tt.fitsP)
+		}
+	}
+}
+
+var decDigitsTests = []struct {
+	x                        *inf.Dec
+	intDigits, frac, minFrac int
+}{
+	{inf.NewDec(0, 0), 0, 0, 0},
+	{inf.NewDec(0, 3), 0, 3, 0},
+	{inf.NewDec(5, 1), 0, 1, 1},
+	{inf.NewDec(-5, 3), 0, 3, 3},
+	{inf.NewDec(1, 0), 1, 0, 0},
+	{inf.NewDec(-12345, 2), 3, 2, 2},
+	{inf.NewDec(12300, 2), 3, 2, 0},
+	{inf.NewDec(12310, 2), 3, 2, 1},
+	{inf.NewDec(1, -3), 4, 0, 0},
+	{inf.NewDec(999999999999999999, 0), 18, 0, 0},
+	{inf.NewDec(1000000000000000000, 0), 19, 0, 0},
+	{inf.NewDec(1<<62, 0), 19, 0, 0},
+	{inf.NewDec(math.MaxInt64, 0), 19, 0, 0},
+	{inf.NewDec(math.MinInt64, 0), 19, 0, 0},
+	{inf.NewDec(-1000000000000000000, 30), 0, 30, 12},
+}
+
+func TestDecDigits(t *testing.T) {
+	for i, tt := range decDigitsTests {
+		if n := tt.x.IntDigits(); n != tt.intDigits {
+			t.Errorf("#%d %v IntDigits got %d; expected %d", i, tt.x, n, tt.intDigits)
+		}
+		if n := tt.x.FracDigits(); n != tt.frac {
+			t.Errorf("#%d %v FracDigits got %d; expected %d", i, tt.x, n, tt.frac)
+		}
+		if n := tt.x.MinFracDigits(); n != tt.minFrac {
+			t.Errorf("#%d %v MinFracDigits got %d; expected %d", i, tt.x, n, tt.minFrac)
+		}
+	}
+}
+
+func TestDecPrecision(t *testing.T) {
+	for i, tt := range []struct {
+		x *inf.Dec
+		p int
+	}{
+		{inf.NewDec(0, 0), 1},
+		{inf.NewDec(0, 5), 1},
+		{inf.NewDec(7, 3), 1},
+		{inf.NewDec(-120, 2), 3},
+		{inf.NewDec(120, -2), 3},
+		{inf.NewDec(999999999999999999, 0), 18},
+		{inf.NewDec(1000000000000000000, 0), 19},
+		{inf.NewDec(1<<62, 0), 19},
+		{inf.NewDec(math.MaxInt64, 0), 19},
+		{inf.NewDec(-math.MaxInt64, 0), 19},
+		{inf.NewDecBig(new(big.Int).Exp(big.NewInt(10), big.NewInt(100), nil), 0), 101},
+		{inf.NewDecBig(new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10), big.NewInt(100), nil), big.NewInt(1)), 0), 100},
+	} {
+		if p := tt.x.Precision(); p != tt.p {
+			t.Errorf("#%d %v Precision got %d; expected %d", i, tt.x, p, tt.p)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package inf
+
+import (
+	"strings"
+)
+
+// DisplayOptions control details of the display form of decimals, on which
+// user interfaces and file formats disagree. The zero value formats as
+// String does.
+type DisplayOptions struct {
+	// TrimZeros omits trailing zeros of the fraction part, along with the
+	// decimal point if no fraction digits remain ("1.2" for 1.20, "12" for
+	// 12.00).
+	TrimZeros bool
+	// ZeroInt formats zero as "0", regardless of its scale (instead of as
+	// "0.00" for zero with scale 2).
+	ZeroInt bool
+	// NoLeadingZero omits the zero integer part of values with a fraction
+	// part and an absolute value less than 1 (".5" for 0.5, "-.05" for
+	// -0.05).
+	NoLeadingZero bool
+	// Grouping, if not nil, groups the digits of the integer part and sets the
+	// decimal point, as by Grouping.Format.
+	Grouping *Grouping
+}
+
+// Format returns the display form of x according to o.
+func (o *DisplayOptions) Format(x *Dec) string {
+	y := x
+	switch {
+	case o.ZeroInt && x.Sign() == 0:
+		y = NewDec(0, 0)
+	case o.TrimZeros && x.Scale() > 0:
+		y = new(Dec).Canonical(x)
+	}
+	var str string
+	if o.Grouping != nil {
+		str = o.Grouping.Format(y)
+	} else {
+		str = y.String()
+	}
+	if o.NoLeadingZero && y.Scale() > 0 {
+		point := "."
+		if o.Grouping != nil {
+			point = string(o.Grouping.point())
+		}
+		neg := strings.HasPrefix(str, "-")
+		if t := strings.TrimPrefix(str, "-"); strings.HasPrefix(t, "0"+point) {
+			str = t[1:]
+			if neg {
+				str = "-" + str
+			}
+		}
+	}
+	return str
+}
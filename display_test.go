@@ -0,0 +1,49 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecDisplayOptions(t *testing.T) {
+	trim := &inf.DisplayOptions{TrimZeros: true}
+	zero := &inf.DisplayOptions{ZeroInt: true}
+	lead := &inf.DisplayOptions{NoLeadingZero: true}
+	all := &inf.DisplayOptions{TrimZeros: true, ZeroInt: true, NoLeadingZero: true,
+		Grouping: &inf.Grouping{Sep: '.', Point: ',', Sizes: []int{3}}}
+	for i, tt := range []struct {
+		o   *inf.DisplayOptions
+		x   string
+		out string
+	}{
+		{&inf.DisplayOptions{}, "1.20", "1.20"},
+		{trim, "1.20", "1.2"},
+		{trim, "12.00", "12"},
+		{trim, "1200.00", "1200"},
+		{trim, "-0.050", "-0.05"},
+		{trim, "0.00", "0"},
+		{trim, "1200", "1200"},
+		{zero, "0.00", "0"},
+		{zero, "1.20", "1.20"},
+		{lead, "0.5", ".5"},
+		{lead, "-0.05", "-.05"},
+		{lead, "0.00", ".00"},
+		{lead, "1.5", "1.5"},
+		{lead, "0", "0"},
+		{all, "1234.500", "1.234,5"},
+		{all, "-0.250", "-,25"},
+		{all, "0.000", "0"},
+	} {
+		x := decs(tt.x)[0]
+		if s := tt.o.Format(x); s != tt.out {
+			t.Errorf("#%d Format(%v) with %+v got %q; expected %q", i, x, *tt.o, s, tt.out)
+		}
+		if x.String() != tt.x {
+			t.Errorf("#%d Format modified its argument to %v", i, x)
+		}
+	}
+	if s := trim.Format(inf.NewDec(12, -2)); s != "1200" {
+		t.Errorf("Format(1200) got %q", s)
+	}
+}
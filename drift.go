@@ -0,0 +1,88 @@
+package inf
+
+import (
+	"math/big"
+)
+
+// A Drift accumulates the exact amounts discarded by rounding, across all
+// operations rounded with a Rounder obtained from its Rounder method. Each
+// rounding adds the difference between the exact (unrounded) result and the
+// rounded result, so that a positive drift means that the rounded results
+// are smaller than the exact ones in total, and a negative drift means that
+// they are larger.
+//
+// The zero value is an empty Drift ready to use. A Drift is not safe for
+// concurrent use; concurrent computations can use a Drift each, and combine
+// them with Add.
+type Drift struct {
+	sum big.Rat
+	n   int
+}
+
+// Rounder returns a Rounder that rounds as r, and adds the amount discarded
+// by each rounding to d. Roundings that fail (such as with RoundExact) are not
+// counted.
+func (d *Drift) Rounder(r Rounder) Rounder {
+	return driftRounder{d, r}
+}
+
+type driftRounder struct {
+	d *Drift
+	r Rounder
+}
+
+func (dr driftRounder) UseRemainder() bool {
+	return true
+}
+
+func (dr driftRounder) Round(z, quo *Dec, remNum, remDen *big.Int) *Dec {
+	var zz *Dec
+	if dr.r.UseRemainder() {
+		zz = dr.r.Round(z, quo, remNum, remDen)
+	} else {
+		zz = dr.r.Round(z, quo, nil, nil)
+	}
+	if zz == nil {
+		return nil
+	}
+	// exact - rounded = quo + (remNum/remDen) * 10**(-s) - zz
+	one := NewDec(1, 0)
+	diff := new(big.Rat).SetFrac(remNum, remDen)
+	diff.Mul(diff, quoRat(NewDec(1, quo.Scale()), one))
+	diff.Add(diff, quoRat(quo, one))
+	diff.Sub(diff, quoRat(zz, one))
+	dr.d.sum.Add(&dr.d.sum, diff)
+	dr.d.n++
+	return zz
+}
+
+// Count returns the number of roundings added to d.
+func (d *Drift) Count() int {
+	return d.n
+}
+
+// Rat returns the exact total amount discarded by rounding.
+func (d *Drift) Rat() *big.Rat {
+	return new(big.Rat).Set(&d.sum)
+}
+
+// Dec returns the total amount discarded by rounding, rounded using the given
+// Rounder to the specified scale. It returns nil if the rounder is RoundExact
+// but the total can not be expressed exactly at the specified scale.
+func (d *Drift) Dec(s Scale, r Rounder) *Dec {
+	return new(Dec).QuoRound(NewDecBig(new(big.Int).Set(d.sum.Num()), 0),
+		NewDecBig(new(big.Int).Set(d.sum.Denom()), 0), s, r)
+}
+
+// Add adds the roundings accumulated in e to d, and returns d.
+func (d *Drift) Add(e *Drift) *Drift {
+	d.sum.Add(&d.sum, &e.sum)
+	d.n += e.n
+	return d
+}
+
+// Reset resets d to an empty Drift.
+func (d *Drift) Reset() {
+	d.sum.SetInt64(0)
+	d.n = 0
+}
@@ -0,0 +1,56 @@
+package inf_test
+
+import (
+	"math/big"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecDrift(t *testing.T) {
+	for i, tt := range []struct {
+		r     inf.Rounder
+		drift string // as a big.Rat
+	}{
+		// 10/3 = 3.33(3), 2/3 = 0.66(6), -1/8 = -0.125
+		{inf.RoundHalfEven, "-1/200"}, // 3.33, 0.67, -0.12
+		{inf.RoundDown, "1/200"},      // 3.33, 0.66, -0.12
+		{inf.RoundFloor, "3/200"},     // 3.33, 0.66, -0.13
+		{inf.RoundCeil, "-3/200"},     // 3.34, 0.67, -0.12
+	} {
+		var d inf.Drift
+		r := d.Rounder(tt.r)
+		new(inf.Dec).QuoRound(inf.NewDec(10, 0), inf.NewDec(3, 0), 2, r)
+		new(inf.Dec).QuoRound(inf.NewDec(2, 0), inf.NewDec(3, 0), 2, r)
+		new(inf.Dec).QuoRound(inf.NewDec(-1, 0), inf.NewDec(8, 0), 2, r)
+		exp, _ := new(big.Rat).SetString(tt.drift)
+		if d.Rat().Cmp(exp) != 0 || d.Count() != 3 {
+			t.Errorf("#%d drift got %v (%d roundings); expected %v (3)", i, d.Rat(), d.Count(), exp)
+		}
+	}
+}
+
+func TestDecDriftRound(t *testing.T) {
+	var d, e inf.Drift
+	r := d.Rounder(inf.RoundHalfUp)
+	for _, x := range []*inf.Dec{inf.NewDec(1005, 3), inf.NewDec(-2004, 3), inf.NewDec(15, -1)} {
+		new(inf.Dec).Round(x, -2, r) // rounds 150 to 200
+		new(inf.Dec).Round(x, 2, r)
+	}
+	// failures are not counted
+	if z := new(inf.Dec).Round(inf.NewDec(1, 3), 2, e.Rounder(inf.RoundExact)); z != nil || e.Count() != 0 {
+		t.Errorf("failed rounding got %v, counted %d", z, e.Count())
+	}
+	new(inf.Dec).Round(inf.NewDec(5, 1), 0, e.Rounder(inf.RoundDown))
+	d.Add(&e)
+	// 1.005 -> 0 (1.005), -2.004 -> 0 (-2.004), 150 -> 200 (-50),
+	// 1.005 -> 1.01 (-0.005), -2.004 -> -2.00 (-0.004), 150 -> 150 (0),
+	// 0.5 -> 0 (0.5)
+	if z := d.Dec(3, inf.RoundExact); z == nil || z.String() != "-50.508" || d.Count() != 7 {
+		t.Errorf("drift got %v (%d roundings); expected -50.508 (7)", z, d.Count())
+	}
+	d.Reset()
+	if d.Rat().Sign() != 0 || d.Count() != 0 {
+		t.Errorf("Reset drift got %v (%d roundings)", d.Rat(), d.Count())
+	}
+}
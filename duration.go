@@ -0,0 +1,32 @@
+package inf
+
+import (
+	"time"
+)
+
+// nanoseconds per second, as a scale
+const durationScale Scale = 9
+
+// FromDuration allocates and returns a new Dec set to the duration d expressed
+// in seconds, with the given scale. When the scale is less than 9, the
+// fractional seconds are truncated towards zero (as by d.Truncate);
+// otherwise the result is exact.
+func FromDuration(d time.Duration, scale Scale) *Dec {
+	z := NewDec(int64(d), durationScale)
+	if scale == durationScale {
+		return z
+	}
+	return z.Round(z, scale, RoundDown)
+}
+
+// ToDuration returns the value of x, interpreted as a number of seconds, as a
+// time.Duration. Fractions of a nanosecond are rounded using the given
+// Rounder.
+//
+// ToDuration returns an error if the rounder is RoundExact but x is not a
+// whole number of nanoseconds, or if the result is out of the range of
+// time.Duration.
+func (x *Dec) ToDuration(r Rounder) (time.Duration, error) {
+	ns, err := x.scaledInt64("ToDuration", durationScale, r)
+	return time.Duration(ns), err
+}
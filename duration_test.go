@@ -0,0 +1,64 @@
+package inf_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"gopkg.in/inf.v0"
+)
+
+var decFromDurationTests = []struct {
+	d     time.Duration
+	scale inf.Scale
+	out   string
+}{
+	{0, 0, "0"},
+	{time.Second, 0, "1"},
+	{time.Second, 3, "1.000"},
+	{1500 * time.Millisecond, 0, "1"},
+	{-1500 * time.Millisecond, 0, "-1"},
+	{1500 * time.Millisecond, 1, "1.5"},
+	{time.Nanosecond, 9, "0.000000001"},
+	{time.Nanosecond, 12, "0.000000001000"},
+	{time.Nanosecond, 8, "0.00000000"},
+	{-time.Hour, 2, "-3600.00"},
+	{math.MaxInt64, 9, "9223372036.854775807"},
+}
+
+func TestDecFromDuration(t *testing.T) {
+	for i, tt := range decFromDurationTests {
+		z := inf.FromDuration(tt.d, tt.scale)
+		if s := z.String(); s != tt.out {
+			t.Errorf("#%d FromDuration(%v, %d) got %s; expected %s", i, tt.d, tt.scale, s, tt.out)
+		}
+	}
+}
+
+var decToDurationTests = []struct {
+	in string
+	r  inf.Rounder
+	d  time.Duration
+	ok bool
+}{
+	{"0", inf.RoundExact, 0, true},
+	{"1.5", inf.RoundExact, 1500 * time.Millisecond, true},
+	{"-60", inf.RoundExact, -time.Minute, true},
+	{"0.0000000015", inf.RoundExact, 0, false},
+	{"0.0000000015", inf.RoundHalfEven, 2 * time.Nanosecond, true},
+	{"0.0000000015", inf.RoundDown, time.Nanosecond, true},
+	{"-0.0000000015", inf.RoundFloor, -2 * time.Nanosecond, true},
+	{"9223372036.854775807", inf.RoundExact, math.MaxInt64, true},
+	{"9223372036.854775808", inf.RoundExact, 0, false},
+	{"-9223372036.854775808", inf.RoundExact, math.MinInt64, true},
+}
+
+func TestDecToDuration(t *testing.T) {
+	for i, tt := range decToDurationTests {
+		x, _ := new(inf.Dec).SetString(tt.in)
+		d, err := x.ToDuration(tt.r)
+		if (err == nil) != tt.ok || tt.ok && d != tt.d {
+			t.Errorf("#%d ToDuration of %v got %v, %v; expected %v, ok %v", i, x, d, err, tt.d, tt.ok)
+		}
+	}
+}
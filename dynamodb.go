@@ -0,0 +1,97 @@
+package inf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Limits of the DynamoDB Number type.
+const (
+	// DynamoDBMaxDigits is the maximum number of significant digits.
+	DynamoDBMaxDigits = 38
+	// DynamoDBMinExp and DynamoDBMaxExp are the minimum and maximum
+	// exponents of the most significant digit of non-zero values; that is,
+	// non-zero values have absolute values from 1E-130 up to (but
+	// excluding) 1E+126.
+	DynamoDBMinExp = -130
+	DynamoDBMaxExp = 125
+)
+
+// DynamoDBNumber returns the representation of x as a DynamoDB number
+// string. If x has more than DynamoDBMaxDigits significant digits, it is
+// rounded to DynamoDBMaxDigits significant digits using r. The result is in
+// canonical form (see Canonical), as DynamoDB trims leading and trailing
+// zeros.
+//
+// DynamoDBNumber returns an error if r is RoundExact and x has more than
+// DynamoDBMaxDigits significant digits, or if the (rounded) value is out of
+// the range of the Number type; values are never silently rounded to zero.
+func (x *Dec) DynamoDBNumber(r Rounder) (string, error) {
+	z := new(Dec)
+	if u, s := x.reduced(); numDigits(u) > DynamoDBMaxDigits {
+		if z.Round(x, checkScale(int64(s)-int64(numDigits(u)-DynamoDBMaxDigits)), r) == nil {
+			return "", fmt.Errorf("Dec.DynamoDBNumber: %v has more than %d significant digits", x, DynamoDBMaxDigits)
+		}
+		z.Canonical(z)
+	} else {
+		z.Canonical(x)
+	}
+	if err := checkDynamoDB("DynamoDBNumber", z); err != nil {
+		return "", err
+	}
+	return z.String(), nil
+}
+
+// checkDynamoDB checks that x is in the range of DynamoDB numbers; op is the
+// name of the calling method, for errors.
+func checkDynamoDB(op string, x *Dec) error {
+	if x.Sign() == 0 {
+		return nil
+	}
+	u, s := x.reduced()
+	n := numDigits(u)
+	if n > DynamoDBMaxDigits {
+		return fmt.Errorf("Dec.%s: %v has more than %d significant digits", op, x, DynamoDBMaxDigits)
+	}
+	if e := int64(n) - 1 - int64(s); e < DynamoDBMinExp || e > DynamoDBMaxExp {
+		return fmt.Errorf("Dec.%s: %v is out of the range of DynamoDB numbers", op, x)
+	}
+	return nil
+}
+
+// SetDynamoDBNumber sets z to the value of the DynamoDB number string s, and
+// returns z. In addition to the format accepted by SetString, s may have an
+// exponent ("1.5E+3"), which is applied to the scale of z.
+//
+// SetDynamoDBNumber returns an error if s is not a valid number, or if its
+// value exceeds the limits of the DynamoDB Number type; the value of z is
+// undefined in that case. The error is ErrDigitLimit if the digits or the
+// scale of the value exceed MaxDigits.
+func (z *Dec) SetDynamoDBNumber(s string) (*Dec, error) {
+	mant, exp := s, int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Dec.SetDynamoDBNumber: invalid DynamoDB number %q", s)
+		}
+		mant, exp = s[:i], e
+	}
+	if err := z.setString(mant); err == ErrDigitLimit {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("Dec.SetDynamoDBNumber: invalid DynamoDB number %q", s)
+	}
+	scale := int64(z.Scale()) - exp
+	if !validScale(scale) {
+		return nil, fmt.Errorf("Dec.SetDynamoDBNumber: invalid DynamoDB number %q", s)
+	}
+	z.SetScale(Scale(scale))
+	if err := z.checkDigitLimit(); err != nil {
+		return nil, err
+	}
+	if err := checkDynamoDB("SetDynamoDBNumber", z); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
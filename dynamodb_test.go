@@ -0,0 +1,71 @@
+package inf_test
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecDynamoDBNumber(t *testing.T) {
+	digits38 := strings.Repeat("9", 38)
+	for i, tt := range []struct {
+		x   *inf.Dec
+		r   inf.Rounder
+		out string // empty if an error is expected
+	}{
+		{decs("1.20")[0], inf.RoundExact, "1.2"},
+		{decs("-0.000")[0], inf.RoundExact, "0"},
+		{decs(digits38)[0], inf.RoundExact, digits38},
+		{decs("0." + digits38 + "0")[0], inf.RoundExact, "0." + digits38},
+		{decs("1." + strings.Repeat("0", 37) + "5")[0], inf.RoundExact, ""},
+		{decs("1." + strings.Repeat("0", 37) + "5")[0], inf.RoundHalfEven, "1"},
+		{decs("1." + strings.Repeat("0", 36) + "15")[0], inf.RoundHalfEven,
+			"1." + strings.Repeat("0", 36) + "2"},
+		{decs(digits38 + "9")[0], inf.RoundHalfUp, "1" + strings.Repeat("0", 39)},
+		{inf.NewDec(1, -125), inf.RoundExact, "1" + strings.Repeat("0", 125)},
+		{inf.NewDec(1, -126), inf.RoundExact, ""},
+		{inf.NewDec(1, 130), inf.RoundExact, "0." + strings.Repeat("0", 129) + "1"},
+		{inf.NewDec(-1, 131), inf.RoundExact, ""},
+	} {
+		s, err := tt.x.DynamoDBNumber(tt.r)
+		if tt.out == "" {
+			if err == nil {
+				t.Errorf("#%d DynamoDBNumber(%v) got %q; expected error", i, tt.x, s)
+			}
+			continue
+		}
+		if err != nil || s != tt.out {
+			t.Errorf("#%d DynamoDBNumber(%v) got %q, %v; expected %q", i, tt.x, s, err, tt.out)
+		}
+	}
+}
+
+func TestDecSetDynamoDBNumber(t *testing.T) {
+	for i, tt := range []struct {
+		in  string
+		exp *inf.Dec // nil if an error is expected
+	}{
+		{"1.2", inf.NewDec(12, 1)},
+		{"-100", inf.NewDec(-100, 0)},
+		{"1.5E+3", inf.NewDec(15, -2)},
+		{"1E-130", inf.NewDec(1, 130)},
+		{"9.9E+125", inf.NewDec(99, -124)},
+		{"1E+126", nil},
+		{"1E-131", nil},
+		{"1." + strings.Repeat("1", 38), nil},
+		{"1.5E", nil},
+		{"abc", nil},
+	} {
+		z, err := new(inf.Dec).SetDynamoDBNumber(tt.in)
+		if tt.exp == nil {
+			if err == nil {
+				t.Errorf("#%d SetDynamoDBNumber(%q) got %v; expected error", i, tt.in, z)
+			}
+			continue
+		}
+		if err != nil || z.Cmp(tt.exp) != 0 || z.Scale() != tt.exp.Scale() {
+			t.Errorf("#%d SetDynamoDBNumber(%q) got %v, %v; expected %v", i, tt.in, z, err, tt.exp)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package inf
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The methods in this file (and QuoExactErr) are error-returning variants of
+// the Dec methods of similar names: instead of returning nil or panicking for
+// invalid operands (including nil operands) or results, they return nil and
+// an error describing the failure, so that it can be propagated.
+//
+// Errors returned by a single function or method are prefixed with its name
+// (such as "Dec.SetStringScale:" or "ParseDec:"); the errors defined here,
+// which are shared by many of them, are prefixed with the package name.
+
+var (
+	// ErrDivisionByZero is returned when the divisor is zero.
+	ErrDivisionByZero = errors.New("inf: division by zero")
+	// ErrScaleOverflow is returned (or used as the panic value) when the
+	// scale of a result can not be represented as a Scale.
+	ErrScaleOverflow = errors.New("inf: scale overflow")
+	// ErrDigitLimit is returned (or used as the panic value) when an input
+	// or an intermediate value exceeds MaxDigits.
+	ErrDigitLimit = errors.New("inf: digit limit exceeded")
+	// ErrNilDec is returned (or used as the panic value) when an operand or
+	// the receiver of an arithmetic operation is a nil *Dec, as these may not
+	// be nil. Functions that document nil as a valid argument (such as Min,
+	// Max and String, which returns "<nil>") accept it.
+	ErrNilDec = errors.New("inf: nil *Dec")
+)
+
+// errRounder is returned when the Rounder returns nil for a result that can
+// be represented exactly.
+var errRounder = errors.New("inf: Rounder returned nil")
+
+// recoverErr recovers from a panic with ErrScaleOverflow, ErrDigitLimit or
+// ErrNilDec, setting *z to nil and *err to the error. It must be called
+// directly by a deferred call.
+func recoverErr(z **Dec, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if r != ErrScaleOverflow && r != ErrDigitLimit && r != ErrNilDec {
+		panic(r)
+	}
+	*z, *err = nil, r.(error)
+}
+
+// ParseDec returns a new Dec set to the value of s, in the format accepted by
+// SetString, or an error if s is not a valid decimal. If s has more digits
+// than MaxDigits allows, the error is ErrDigitLimit.
+func ParseDec(s string) (*Dec, error) {
+	z := new(Dec)
+	if err := z.setString(s); err == ErrDigitLimit {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("ParseDec: invalid decimal %q", s)
+	}
+	return z, nil
+}
+
+// MulErr sets z to the product x*y and returns z, as Mul does, or returns
+// ErrScaleOverflow if the scale of the product (the sum of the scales of x
+// and y) overflows Scale.
+func (z *Dec) MulErr(x, y *Dec) (zz *Dec, err error) {
+	defer recoverErr(&zz, &err)
+	return z.Mul(x, y), nil
+}
+
+// QuoErr sets z to the quotient x/y, rounded using the given Rounder to the
+// scale obtained from the given Scaler, and returns z, as Quo does. It returns
+// ErrDivisionByZero if y is zero, and an *InexactError if the Rounder returns
+// nil (as RoundExact does when the quotient can not be expressed exactly at
+// the scale obtained). It returns ErrScaleOverflow or ErrDigitLimit if the
+// quotient exceeds the limits on scales and digits. The value of z is
+// undefined in case of an error.
+func (z *Dec) QuoErr(x, y *Dec, s Scaler, r Rounder) (zz *Dec, err error) {
+	defer recoverErr(&zz, &err)
+	if y.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+	scl := s.Scale(x, y)
+	if zz := z.quo(x, y, sclr{scl}, r); zz != nil {
+		return zz, nil
+	}
+	return nil, quoErr(x, y, scl)
+}
+
+// RoundErr sets z to the value of x rounded to Scale s using Rounder r, and
+// returns z, as Round does. It returns an *InexactError if the Rounder
+// returns nil (as RoundExact does when x can not be expressed exactly at the
+// scale s), or ErrScaleOverflow or ErrDigitLimit if rescaling x exceeds the
+// limits on scales and digits; the value of z is undefined in case of an
+// error.
+func (z *Dec) RoundErr(x *Dec, s Scale, r Rounder) (zz *Dec, err error) {
+	defer recoverErr(&zz, &err)
+	if zz := z.Round(x, s, r); zz != nil {
+		return zz, nil
+	}
+	return nil, quoErr(x, NewDec(1, 0), s)
+}
+
+// quoErr returns the error for a Rounder returning nil for the quotient x/y
+// at the scale s.
+func quoErr(x, y *Dec, s Scale) error {
+	if _, rA, rB := new(Dec).QuoRem(x, y, s); rA.Sign() != 0 {
+		return inexactError(x, y, s, rA, rB)
+	}
+	return errRounder
+}
@@ -0,0 +1,117 @@
+package inf_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+// expectPanic calls f and reports an error unless it panics with want.
+func expectPanic(t *testing.T, want error, f func()) {
+	t.Helper()
+	defer func() {
+		if r := recover(); r != want {
+			t.Errorf("got panic %v; expected %v", r, want)
+		}
+	}()
+	f()
+}
+
+func TestParseDec(t *testing.T) {
+	if z, err := inf.ParseDec("-1.50"); err != nil || z.String() != "-1.50" {
+		t.Errorf("ParseDec(-1.50) got %v, %v; expected -1.50", z, err)
+	}
+	for _, s := range []string{"", "1.2.3", "abc", "1e5x"} {
+		if z, err := inf.ParseDec(s); z != nil || err == nil {
+			t.Errorf("ParseDec(%q) got %v, %v; expected an error", s, z, err)
+		}
+	}
+}
+
+func TestDecErrVariants(t *testing.T) {
+	one, three, zero := inf.NewDec(1, 0), inf.NewDec(3, 0), inf.NewDec(0, 0)
+	if z, err := new(inf.Dec).QuoErr(one, three, inf.ScaleFixed(2), inf.RoundHalfEven); err != nil || z.String() != "0.33" {
+		t.Errorf("QuoErr(1, 3) got %v, %v; expected 0.33", z, err)
+	}
+	if _, err := new(inf.Dec).QuoErr(one, zero, inf.ScaleFixed(2), inf.RoundHalfEven); err != inf.ErrDivisionByZero {
+		t.Errorf("QuoErr(1, 0) got error %v; expected ErrDivisionByZero", err)
+	}
+	_, err := new(inf.Dec).QuoErr(one, inf.NewDec(8, 0), inf.ScaleFixed(2), inf.RoundExact)
+	if e, ok := err.(*inf.InexactError); !ok || !e.Finite || e.RequiredScale != 3 {
+		t.Errorf("QuoErr(1, 8) with RoundExact got error %v; expected *InexactError requiring scale 3", err)
+	}
+	if z, err := new(inf.Dec).RoundErr(inf.NewDec(125, 2), 1, inf.RoundHalfEven); err != nil || z.String() != "1.2" {
+		t.Errorf("RoundErr(1.25) got %v, %v; expected 1.2", z, err)
+	}
+	_, err = new(inf.Dec).RoundErr(inf.NewDec(125, 2), 1, inf.RoundExact)
+	if e, ok := err.(*inf.InexactError); !ok || e.RequiredScale != 2 || e.Remainder.RatString() != "1/20" {
+		t.Errorf("RoundErr(1.25) with RoundExact got error %v; expected *InexactError", err)
+	}
+	if z, err := new(inf.Dec).MulErr(inf.NewDec(15, 1), inf.NewDec(2, 3)); err != nil || z.String() != "0.0030" {
+		t.Errorf("MulErr(1.5, 0.002) got %v, %v; expected 0.0030", z, err)
+	}
+	big := inf.NewDec(1, math.MaxInt32)
+	if _, err := new(inf.Dec).MulErr(big, big); err != inf.ErrScaleOverflow {
+		t.Errorf("MulErr with scale %d got error %v; expected ErrScaleOverflow", big.Scale(), err)
+	}
+}
+
+func TestScaleOverflow(t *testing.T) {
+	hi, lo := inf.NewDec(1, math.MaxInt32), inf.NewDec(1, math.MinInt32)
+	expectPanic(t, inf.ErrScaleOverflow, func() { new(inf.Dec).Mul(hi, inf.NewDec(1, 1)) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { new(inf.Dec).Mul(lo, lo) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { new(inf.Dec).QuoRound(hi, lo, 0, inf.RoundDown) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { new(inf.Dec).Round(lo, math.MaxInt32, inf.RoundDown) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { new(inf.Dec).Add(lo, hi) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { new(inf.Dec).MovePointLeft(hi, 1) })
+	// results at the limits are valid
+	if z := new(inf.Dec).Mul(hi, inf.NewDec(1, -1)); z.Scale() != math.MaxInt32-1 {
+		t.Errorf("Mul got scale %d; expected %d", z.Scale(), math.MaxInt32-1)
+	}
+}
+
+func TestScaleLimits(t *testing.T) {
+	if z := new(inf.Dec).Reduce(inf.NewDec(1000, inf.MinScale+1)); z.UnscaledBig().Int64() != 100 || z.Scale() != inf.MinScale {
+		t.Errorf("Reduce got unscaled %v, scale %d; expected 100, %d", z.UnscaledBig(), z.Scale(), inf.MinScale)
+	}
+	if z := new(inf.Dec).Reduce(inf.NewDec(1000, inf.MaxScale)); z.UnscaledBig().Int64() != 1 || z.Scale() != inf.MaxScale-3 {
+		t.Errorf("Reduce got unscaled %v, scale %d; expected 1, %d", z.UnscaledBig(), z.Scale(), inf.MaxScale-3)
+	}
+	if s := new(inf.Dec).Neg(inf.NewDec(-1, inf.MinScale)).Scale(); s != inf.MinScale {
+		t.Errorf("Neg got scale %d; expected %d", s, inf.MinScale)
+	}
+	expectPanic(t, inf.ErrScaleOverflow, func() { new(inf.Dec).NonNegScale(inf.NewDec(1, inf.MinScale)) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { new(inf.Dec).Canonical(inf.NewDec(1, inf.MinScale)) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { new(inf.Dec).Inv(inf.NewDec(1, inf.MinScale), inf.ScaleFixed(0), inf.RoundDown) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { new(inf.Dec).Round(inf.NewDec(1, inf.MinScale), inf.MaxScale, inf.RoundDown) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { new(inf.Dec).MovePointRight(inf.NewDec(1, inf.MinScale), 1) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { inf.NewDecPow10(1 << 32) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { inf.NewDecPow10(-(1 << 32) - 3) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { inf.NewDecParts(false, 1, 0, 1<<32) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { new(inf.Dec).SetQ(big.NewInt(1), 1<<32) })
+	expectPanic(t, inf.ErrScaleOverflow, func() { inf.CAGR(inf.NewDec(1, 0), inf.NewDec(2, 0), 4, 1<<30, inf.RoundDown) })
+	if z := inf.NewDecPow10(-math.MaxInt32); z.Scale() != inf.MaxScale {
+		t.Errorf("NewDecPow10(%d) got scale %d; expected %d", -math.MaxInt32, z.Scale(), inf.MaxScale)
+	}
+}
+
+func TestNilDec(t *testing.T) {
+	var nilDec *inf.Dec
+	one := inf.NewDec(1, 0)
+	expectPanic(t, inf.ErrNilDec, func() { new(inf.Dec).Add(one, nilDec) })
+	expectPanic(t, inf.ErrNilDec, func() { new(inf.Dec).Mul(nilDec, one) })
+	expectPanic(t, inf.ErrNilDec, func() { one.Cmp(nilDec) })
+	expectPanic(t, inf.ErrNilDec, func() { nilDec.Set(one) })
+	expectPanic(t, inf.ErrNilDec, func() { nilDec.Sign() })
+	if z, err := new(inf.Dec).MulErr(one, nilDec); z != nil || err != inf.ErrNilDec {
+		t.Errorf("MulErr(1, nil) got %v, %v; expected ErrNilDec", z, err)
+	}
+	if z, err := new(inf.Dec).QuoErr(nilDec, one, inf.ScaleFixed(2), inf.RoundHalfEven); z != nil || err != inf.ErrNilDec {
+		t.Errorf("QuoErr(nil, 1) got %v, %v; expected ErrNilDec", z, err)
+	}
+	if s := nilDec.String(); s != "<nil>" {
+		t.Errorf("String() of nil got %q; expected <nil>", s)
+	}
+}
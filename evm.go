@@ -0,0 +1,77 @@
+package inf
+
+import (
+	"fmt"
+	"math/big"
+)
+
+var (
+	two256 = new(big.Int).Lsh(bigInt[1], 256)
+	two255 = new(big.Int).Lsh(bigInt[1], 255)
+)
+
+// scaledWord returns the value of x with the given scale as an integer, or an
+// error if x can not be represented exactly with the scale.
+func (x *Dec) scaledWord(op string, decimals Scale) (*big.Int, error) {
+	z := new(Dec).Round(x, decimals, RoundExact)
+	if z == nil {
+		return nil, fmt.Errorf("Dec.%s: %v has more than %d decimals", op, x, decimals)
+	}
+	return z.UnscaledBig(), nil
+}
+
+// Uint256 returns the value of x as a token amount in base units with the
+// given number of decimals (e.g. 18 for ether and most ERC-20 tokens), as a
+// 32-byte big-endian unsigned integer word, the encoding of a uint256 in the
+// Ethereum ABI.
+//
+// Uint256 returns an error if x has more fractional digits than decimals
+// (other than trailing zeros), or if the result does not fit in a uint256.
+func (x *Dec) Uint256(decimals Scale) (w [32]byte, err error) {
+	v, err := x.scaledWord("Uint256", decimals)
+	if err != nil {
+		return w, err
+	}
+	if v.Sign() < 0 || v.Cmp(two256) >= 0 {
+		return w, fmt.Errorf("Dec.Uint256: %v out of range", x)
+	}
+	v.FillBytes(w[:])
+	return w, nil
+}
+
+// Int256 returns the value of x as a token amount in base units with the
+// given number of decimals, as a 32-byte big-endian two's complement integer
+// word, the encoding of an int256 in the Ethereum ABI.
+//
+// Int256 returns an error if x has more fractional digits than decimals
+// (other than trailing zeros), or if the result does not fit in an int256.
+func (x *Dec) Int256(decimals Scale) (w [32]byte, err error) {
+	v, err := x.scaledWord("Int256", decimals)
+	if err != nil {
+		return w, err
+	}
+	if v.Cmp(two255) >= 0 || new(big.Int).Neg(v).Cmp(two255) > 0 {
+		return w, fmt.Errorf("Dec.Int256: %v out of range", x)
+	}
+	if v.Sign() < 0 {
+		v.Add(v, two256)
+	}
+	v.FillBytes(w[:])
+	return w, nil
+}
+
+// SetUint256 sets z to the value of the 32-byte big-endian unsigned integer
+// word w, interpreted as an amount in base units with the given number of
+// decimals, and returns z. The scale of z is decimals.
+func (z *Dec) SetUint256(w [32]byte, decimals Scale) *Dec {
+	z.UnscaledBig().SetBytes(w[:])
+	return z.SetScale(decimals)
+}
+
+// SetInt256 sets z to the value of the 32-byte big-endian two's complement
+// integer word w, interpreted as an amount in base units with the given
+// number of decimals, and returns z. The scale of z is decimals.
+func (z *Dec) SetInt256(w [32]byte, decimals Scale) *Dec {
+	setTwosComplement(z.UnscaledBig(), w[:])
+	return z.SetScale(decimals)
+}
@@ -0,0 +1,67 @@
+package inf_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func word(s string) (w [32]byte) {
+	s = strings.Repeat("0", 64-len(s)) + s
+	hex.Decode(w[:], []byte(s))
+	return
+}
+
+var decWordTests = []struct {
+	in       string
+	decimals inf.Scale
+	uw, iw   string // hex; empty if an error is expected
+}{
+	{"0", 18, "0", "0"},
+	{"1", 18, "de0b6b3a7640000", "de0b6b3a7640000"},
+	{"1.5", 18, "14d1120d7b160000", "14d1120d7b160000"},
+	{"0.000000000000000001", 18, "1", "1"},
+	{"0.0000000000000000010", 18, "1", "1"},
+	{"0.0000000000000000005", 18, "", ""},
+	{"21000000.00000000", 8, "775f05a074000", "775f05a074000"},
+	{"-1", 0, "", "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+	{"-0.000001", 6, "", "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+	{"-2.56", 2, "", "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00"},
+	{"115792089237316195423570985008687907853269984665640564039457584007913129639935", 0,
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", ""},
+	{"115792089237316195423570985008687907853269984665640564039457584007913129639936", 0, "", ""},
+	{"57896044618658097711785492504343953926634992332820282019728792003956564819967", 0,
+		"7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+	{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", 0,
+		"", "8000000000000000000000000000000000000000000000000000000000000000"},
+	{"-57896044618658097711785492504343953926634992332820282019728792003956564819969", 0, "", ""},
+}
+
+func TestDecWord256(t *testing.T) {
+	for i, tt := range decWordTests {
+		x, _ := new(inf.Dec).SetString(tt.in)
+		uw, err := x.Uint256(tt.decimals)
+		if (err == nil) != (tt.uw != "") || err == nil && uw != word(tt.uw) {
+			t.Errorf("#%d Uint256(%d) of %v got %x, %v; expected %s", i, tt.decimals, x, uw, err, tt.uw)
+		}
+		if err == nil {
+			z := new(inf.Dec).SetUint256(uw, tt.decimals)
+			if z.Cmp(x) != 0 || z.Scale() != tt.decimals {
+				t.Errorf("#%d SetUint256(%x, %d) got %v; expected %v", i, uw, tt.decimals, z, x)
+			}
+		}
+		iw, err := x.Int256(tt.decimals)
+		if (err == nil) != (tt.iw != "") || err == nil && iw != word(tt.iw) {
+			t.Errorf("#%d Int256(%d) of %v got %x, %v; expected %s", i, tt.decimals, x, iw, err, tt.iw)
+		}
+		if err == nil {
+			z := new(inf.Dec).SetInt256(iw, tt.decimals)
+			if z.Cmp(x) != 0 || z.Scale() != tt.decimals {
+				t.Errorf("#%d SetInt256(%x, %d) got %v; expected %v", i, iw, tt.decimals, z, x)
+			}
+		}
+	}
+}
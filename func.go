@@ -0,0 +1,49 @@
+package inf
+
+// The functions in this file are value-style counterparts of the Dec
+// methods of the same names; each allocates and returns a new Dec for the
+// result, leaving the operands unchanged.
+
+// Abs returns |x| (the absolute value of x), as new(Dec).Abs(x).
+func Abs(x *Dec) *Dec {
+	return new(Dec).Abs(x)
+}
+
+// Neg returns -x, as new(Dec).Neg(x).
+func Neg(x *Dec) *Dec {
+	return new(Dec).Neg(x)
+}
+
+// Add returns the sum x+y, as new(Dec).Add(x, y).
+func Add(x, y *Dec) *Dec {
+	return new(Dec).Add(x, y)
+}
+
+// Sub returns the difference x-y, as new(Dec).Sub(x, y).
+func Sub(x, y *Dec) *Dec {
+	return new(Dec).Sub(x, y)
+}
+
+// Mul returns the product x*y, as new(Dec).Mul(x, y).
+func Mul(x, y *Dec) *Dec {
+	return new(Dec).Mul(x, y)
+}
+
+// Round returns x rounded to scale s using Rounder r, as
+// new(Dec).Round(x, s, r).
+func Round(x *Dec, s Scale, r Rounder) *Dec {
+	return new(Dec).Round(x, s, r)
+}
+
+// QuoRound returns the quotient x/y rounded to scale s using Rounder r, as
+// new(Dec).QuoRound(x, y, s, r); it returns nil if the rounder is RoundExact
+// but the result can not be expressed exactly at scale s.
+func QuoRound(x, y *Dec, s Scale, r Rounder) *Dec {
+	return new(Dec).QuoRound(x, y, s, r)
+}
+
+// QuoExact returns the quotient x/y when it is a finite decimal, as
+// new(Dec).QuoExact(x, y); otherwise it returns nil.
+func QuoExact(x, y *Dec) *Dec {
+	return new(Dec).QuoExact(x, y)
+}
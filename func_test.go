@@ -0,0 +1,35 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecFuncs(t *testing.T) {
+	x, y := inf.NewDec(-125, 2), inf.NewDec(5, 1)
+	for i, tt := range []struct {
+		got *inf.Dec
+		exp string
+	}{
+		{inf.Abs(x), "1.25"},
+		{inf.Neg(x), "1.25"},
+		{inf.Add(x, y), "-0.75"},
+		{inf.Sub(x, y), "-1.75"},
+		{inf.Mul(x, y), "-0.625"},
+		{inf.Round(x, 1, inf.RoundHalfEven), "-1.2"},
+		{inf.QuoRound(x, inf.NewDec(3, 0), 3, inf.RoundDown), "-0.416"},
+		{inf.QuoExact(x, y), "-2.5"},
+		{inf.Add(inf.Mul(x, x), inf.Mul(y, y)), "1.8125"},
+	} {
+		if s := tt.got.String(); s != tt.exp {
+			t.Errorf("#%d got %s; expected %s", i, s, tt.exp)
+		}
+	}
+	if x.String() != "-1.25" || y.String() != "0.5" {
+		t.Errorf("operands modified: %v, %v", x, y)
+	}
+	if z := inf.QuoExact(x, inf.NewDec(3, 0)); z != nil {
+		t.Errorf("QuoExact got %v; expected nil", z)
+	}
+}
@@ -0,0 +1,80 @@
+package inf
+
+import (
+	"math/big"
+)
+
+// GCD sets z to the greatest common divisor of x and y and returns z.
+// x and y must have integer values (of any scale, such as 12.00); otherwise
+// GCD returns nil and the value of z is undefined.
+// The result is non-negative and has scale 0; GCD(0, 0) is 0.
+func (z *Dec) GCD(x, y *Dec) *Dec {
+	ix, okx := x.integer()
+	iy, oky := y.integer()
+	if !okx || !oky {
+		return nil
+	}
+	a, b := new(big.Int).Abs(ix), new(big.Int).Abs(iy)
+	z.UnscaledBig().GCD(nil, nil, a, b)
+	return z.SetScale(0)
+}
+
+// LCM sets z to the least common multiple of x and y and returns z.
+// x and y must have integer values (of any scale, such as 12.00); otherwise
+// LCM returns nil and the value of z is undefined.
+// The result is non-negative and has scale 0; it is 0 if x or y is 0.
+func (z *Dec) LCM(x, y *Dec) *Dec {
+	ix, okx := x.integer()
+	iy, oky := y.integer()
+	if !okx || !oky {
+		return nil
+	}
+	if ix.Sign() == 0 || iy.Sign() == 0 {
+		return z.SetUnscaled(0).SetScale(0)
+	}
+	a, b := new(big.Int).Abs(ix), new(big.Int).Abs(iy)
+	g := new(big.Int).GCD(nil, nil, a, b)
+	z.UnscaledBig().Mul(a.Quo(a, g), b)
+	return z.SetScale(0)
+}
+
+// CommonQuantum returns the least scale with which all values in xs can be
+// represented without rounding (see FitsScale); that is, 10**-s is the
+// coarsest power-of-ten step of which all values are integer multiples. The
+// scale may be negative (such as -2 for 1200 and 300). Zero values, which are
+// multiples of any step, are ignored; CommonQuantum returns 0 if there are no
+// other values.
+func CommonQuantum(xs ...*Dec) Scale {
+	var s Scale
+	first := true
+	for _, x := range xs {
+		if x.Sign() == 0 {
+			continue
+		}
+		if _, rs := x.reduced(); first || rs > s {
+			s, first = rs, false
+		}
+	}
+	return s
+}
+
+// CommonStep returns the greatest decimal step of which all values in xs are
+// integer multiples (the greatest common divisor of the values, such as 0.25
+// for 0.50, 1.75 and 2.25), with the scale returned by CommonQuantum. The
+// result is positive. Zero values are ignored; CommonStep returns nil if
+// there are no other values.
+func CommonStep(xs ...*Dec) *Dec {
+	s := CommonQuantum(xs...)
+	g, t := new(big.Int), new(big.Int)
+	for _, x := range xs {
+		if x.Sign() == 0 {
+			continue
+		}
+		t.Abs(x.rescale(s).UnscaledBig())
+		g.GCD(nil, nil, g, t)
+	}
+	if g.Sign() == 0 {
+		return nil
+	}
+	return NewDecBig(g, s)
+}
@@ -0,0 +1,72 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decGCDTests = []struct {
+	x, y     *inf.Dec
+	gcd, lcm *inf.Dec // nil if x or y is not an integer
+}{
+	{inf.NewDec(0, 0), inf.NewDec(0, 0), inf.NewDec(0, 0), inf.NewDec(0, 0)},
+	{inf.NewDec(0, 0), inf.NewDec(6, 0), inf.NewDec(6, 0), inf.NewDec(0, 0)},
+	{inf.NewDec(4, 0), inf.NewDec(6, 0), inf.NewDec(2, 0), inf.NewDec(12, 0)},
+	{inf.NewDec(-4, 0), inf.NewDec(6, 0), inf.NewDec(2, 0), inf.NewDec(12, 0)},
+	{inf.NewDec(-4, 0), inf.NewDec(-6, 0), inf.NewDec(2, 0), inf.NewDec(12, 0)},
+	{inf.NewDec(3000, 2), inf.NewDec(45, 0), inf.NewDec(15, 0), inf.NewDec(90, 0)},
+	{inf.NewDec(3, -1), inf.NewDec(45, 0), inf.NewDec(15, 0), inf.NewDec(90, 0)},
+	{inf.NewDec(7, 0), inf.NewDec(13, 0), inf.NewDec(1, 0), inf.NewDec(91, 0)},
+	{inf.NewDec(15, 1), inf.NewDec(3, 0), nil, nil},
+	{inf.NewDec(3, 0), inf.NewDec(-1, 3), nil, nil},
+}
+
+func TestDecGCD(t *testing.T) {
+	for i, tt := range decGCDTests {
+		g := new(inf.Dec).GCD(tt.x, tt.y)
+		l := new(inf.Dec).LCM(tt.x, tt.y)
+		if tt.gcd == nil {
+			if g != nil || l != nil {
+				t.Errorf("#%d GCD, LCM(%v, %v) got %v, %v; expected nil", i, tt.x, tt.y, g, l)
+			}
+			continue
+		}
+		if g == nil || g.Cmp(tt.gcd) != 0 || g.Scale() != 0 {
+			t.Errorf("#%d GCD(%v, %v) got %v; expected %v", i, tt.x, tt.y, g, tt.gcd)
+		}
+		if l == nil || l.Cmp(tt.lcm) != 0 || l.Scale() != 0 {
+			t.Errorf("#%d LCM(%v, %v) got %v; expected %v", i, tt.x, tt.y, l, tt.lcm)
+		}
+	}
+}
+
+func TestCommonQuantumStep(t *testing.T) {
+	for i, tt := range []struct {
+		xs      []string
+		quantum inf.Scale
+		step    string // empty if nil is expected
+	}{
+		{[]string{"0.50", "1.75", "2.25"}, 2, "0.25"},
+		{[]string{"1.10", "2.2", "-3.30"}, 1, "1.1"},
+		{[]string{"101.5", "99.0", "100.25"}, 2, "0.25"},
+		{[]string{"0.001", "5"}, 3, "0.001"},
+		{[]string{"1200", "300.00", "0"}, -2, "300"},
+		{[]string{"7"}, 0, "7"},
+		{[]string{"0.00", "0"}, 0, ""},
+		{nil, 0, ""},
+	} {
+		xs := decs(tt.xs...)
+		if q := inf.CommonQuantum(xs...); q != tt.quantum {
+			t.Errorf("#%d CommonQuantum%v got %d; expected %d", i, xs, q, tt.quantum)
+		}
+		z := inf.CommonStep(xs...)
+		if tt.step == "" {
+			if z != nil {
+				t.Errorf("#%d CommonStep%v got %v; expected nil", i, xs, z)
+			}
+		} else if z == nil || z.String() != tt.step || z.Scale() != tt.quantum {
+			t.Errorf("#%d CommonStep%v got %v; expected %s", i, xs, z, tt.step)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package inf
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// A Grouping describes the formatting of decimals with grouped digits in the
+// integer part, such as "1,234,567.89" or "12,34,567.89".
+type Grouping struct {
+	// Sep is the group separator.
+	Sep rune
+	// Point is the decimal point; '.' is used if Point is 0.
+	Point rune
+	// Sizes are the sizes of the groups, starting from the decimal point; the
+	// last size is repeated for the remaining digits. For example, {3} groups
+	// thousands and {3, 2} groups as in the Indian numbering system (lakh and
+	// crore). Digits are not grouped if Sizes is empty or Sep is 0.
+	Sizes []int
+}
+
+// Predefined groupings.
+var (
+	// WesternGrouping groups thousands, as in "1,234,567.89".
+	WesternGrouping = Grouping{Sep: ',', Sizes: []int{3}}
+	// IndianGrouping groups as in the Indian numbering system, with the
+	// lowest group of three digits and the others of two (thousands, lakhs,
+	// crores etc), as in "12,34,567.89".
+	IndianGrouping = Grouping{Sep: ',', Sizes: []int{3, 2}}
+)
+
+func (g *Grouping) point() rune {
+	if g.Point == 0 {
+		return '.'
+	}
+	return g.Point
+}
+
+// size returns the size of the ith group from the decimal point.
+func (g *Grouping) size(i int) int {
+	if i < len(g.Sizes) {
+		return g.Sizes[i]
+	}
+	return g.Sizes[len(g.Sizes)-1]
+}
+
+// Format returns the representation of x (as by String) with the digits of
+// the integer part grouped according to g.
+func (g *Grouping) Format(x *Dec) string {
+	s := x.String()
+	b := make([]byte, 0, 2*len(s))
+	if s[0] == '-' {
+		b = append(b, '-')
+		s = s[1:]
+	}
+	intPart, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, frac = s[:i], s[i+1:]
+	}
+	if len(g.Sizes) > 0 && g.Sep != 0 {
+		// split the integer part into groups, from the right
+		var groups []string
+		for i, n := 0, len(intPart); n > 0; i++ {
+			k := g.size(i)
+			if k <= 0 || k > n {
+				k = n
+			}
+			groups = append(groups, intPart[n-k:n])
+			n -= k
+		}
+		for i := len(groups) - 1; i >= 0; i-- {
+			b = append(b, groups[i]...)
+			if i > 0 {
+				b = append(b, string(g.Sep)...)
+			}
+		}
+	} else {
+		b = append(b, intPart...)
+	}
+	if frac != "" {
+		b = append(b, string(g.point())...)
+		b = append(b, frac...)
+	}
+	return string(b)
+}
+
+// Parse parses s, formatted as by Format, and returns the resulting Dec. The
+// integer part may be either grouped exactly as by g, or not grouped at all.
+// Parse returns an error if s is not a valid decimal, or if its digits are
+// grouped differently (such as "1,234,567" with IndianGrouping).
+func (g *Grouping) Parse(s string) (*Dec, error) {
+	t := s
+	neg := false
+	if len(t) > 0 && (t[0] == '+' || t[0] == '-') {
+		neg = t[0] == '-'
+		t = t[1:]
+	}
+	intPart, frac := t, ""
+	if i := strings.IndexRune(t, g.point()); i >= 0 {
+		intPart, frac = t[:i], t[i+utf8.RuneLen(g.point()):]
+	}
+	if g.Sep != 0 && len(g.Sizes) > 0 && strings.ContainsRune(intPart, g.Sep) {
+		groups := strings.Split(intPart, string(g.Sep))
+		for i := len(groups) - 1; i >= 0; i-- {
+			n, k := len(groups[i]), g.size(len(groups)-1-i)
+			if n != k && (i > 0 || n == 0 || n > k) {
+				return nil, fmt.Errorf("Grouping.Parse: invalid digit grouping in %q", s)
+			}
+		}
+		intPart = strings.Join(groups, "")
+	}
+	digits := make([]byte, 0, len(intPart)+len(frac))
+	for _, p := range [...]string{intPart, frac} {
+		for i := 0; i < len(p); i++ {
+			if p[i] < '0' || p[i] > '9' {
+				return nil, fmt.Errorf("Grouping.Parse: invalid decimal %q", s)
+			}
+			digits = append(digits, p[i])
+		}
+	}
+	if len(digits) == 0 || !validScale(int64(len(frac))) {
+		return nil, fmt.Errorf("Grouping.Parse: invalid decimal %q", s)
+	}
+	return new(Dec).setDigits(neg, digits).SetScale(Scale(len(frac))), nil
+}
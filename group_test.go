@@ -0,0 +1,74 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var european = &inf.Grouping{Sep: '.', Point: ',', Sizes: []int{3}}
+
+func TestDecGroupingFormat(t *testing.T) {
+	for i, tt := range []struct {
+		g   *inf.Grouping
+		x   string
+		out string
+	}{
+		{&inf.WesternGrouping, "1234567.89", "1,234,567.89"},
+		{&inf.WesternGrouping, "-123456", "-123,456"},
+		{&inf.WesternGrouping, "123", "123"},
+		{&inf.WesternGrouping, "0.001", "0.001"},
+		{&inf.IndianGrouping, "1234567.89", "12,34,567.89"},
+		{&inf.IndianGrouping, "-123456789", "-12,34,56,789"},
+		{&inf.IndianGrouping, "1000", "1,000"},
+		{&inf.IndianGrouping, "100000", "1,00,000"},
+		{european, "1234567.89", "1.234.567,89"},
+		{&inf.Grouping{}, "1234567.89", "1234567.89"},
+	} {
+		x := decs(tt.x)[0]
+		s := tt.g.Format(x)
+		if s != tt.out {
+			t.Errorf("#%d Format(%v) got %q; expected %q", i, x, s, tt.out)
+		}
+		if z, err := tt.g.Parse(s); err != nil || z.Cmp(x) != 0 || z.Scale() != x.Scale() {
+			t.Errorf("#%d Parse(%q) got %v, %v; expected %v", i, s, z, err, x)
+		}
+	}
+	if s := inf.IndianGrouping.Format(inf.NewDec(12345, -2)); s != "12,34,500" {
+		t.Errorf("Format(1234500) got %q", s)
+	}
+}
+
+func TestDecGroupingParse(t *testing.T) {
+	for i, tt := range []struct {
+		g   *inf.Grouping
+		in  string
+		out string // empty if an error is expected
+	}{
+		{&inf.WesternGrouping, "1234567.89", "1234567.89"},
+		{&inf.WesternGrouping, "+1,234", "1234"},
+		{&inf.WesternGrouping, "12,34,567", ""},
+		{&inf.WesternGrouping, "1,2345", ""},
+		{&inf.WesternGrouping, ",234", ""},
+		{&inf.WesternGrouping, "1,,234", ""},
+		{&inf.WesternGrouping, "1,234.5,6", ""},
+		{&inf.IndianGrouping, "12,34,567.89", "1234567.89"},
+		{&inf.IndianGrouping, "1,234,567", ""},
+		{&inf.IndianGrouping, "1234567", "1234567"},
+		{&inf.IndianGrouping, "", ""},
+		{&inf.IndianGrouping, "-", ""},
+		{european, "1.234,5", "1234.5"},
+		{european, "1,234.5", ""},
+	} {
+		z, err := tt.g.Parse(tt.in)
+		if tt.out == "" {
+			if err == nil {
+				t.Errorf("#%d Parse(%q) got %v; expected error", i, tt.in, z)
+			}
+			continue
+		}
+		if err != nil || z.String() != tt.out {
+			t.Errorf("#%d Parse(%q) got %v, %v; expected %s", i, tt.in, z, err, tt.out)
+		}
+	}
+}
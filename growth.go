@@ -0,0 +1,41 @@
+package inf
+
+// CAGR returns the compound annual growth rate (or more generally, the growth
+// rate per period) from begin to end over the given number of periods; that
+// is, (end/begin)**(1/periods) - 1, rounded using the given Rounder to the
+// specified scale. The result is correctly rounded; for example, the rate for
+// a growth from 100 to 121 over 2 periods is exactly 0.1.
+//
+// CAGR returns nil if begin is not positive, if end is negative, if periods
+// is less than 1, if s is negative, or if the rounder is RoundExact but the
+// result can not be expressed exactly at the specified scale.
+func CAGR(begin, end *Dec, periods int, s Scale, r Rounder) *Dec {
+	if begin.Sign() <= 0 || end.Sign() < 0 || periods < 1 || s < 0 {
+		return nil
+	}
+	ratio := quoRat(end, begin)
+	y, frac := rootParts(ratio.Num(), ratio.Denom(), periods, s)
+	// y is the truncated root; subtract one, and truncate towards zero if the
+	// result is negative
+	g := y.Sub(y, exp10(s))
+	neg := g.Sign() < 0
+	if neg && frac != fracZero {
+		g.Add(g, bigInt[1])
+		frac = fracAboveHalf - frac + fracBelowHalf // 1 - frac
+	}
+	return new(Dec).roundFrac(NewDecBig(g, s), neg, frac, r)
+}
+
+// Compound returns the value of x compounded at the given rate per period
+// over n periods; that is, x * (1+rate)**n. The result is exact, with a scale
+// of x.Scale() + n*rate.Scale() (or x.Scale() if rate.Scale() is negative);
+// it can be rounded as needed.
+//
+// Compound returns nil if n is negative.
+func Compound(x, rate *Dec, n int) *Dec {
+	if n < 0 {
+		return nil
+	}
+	f := new(Dec).Add(rate, NewDec(1, 0))
+	return f.Mul(x, f.Pow(f, int64(n)))
+}
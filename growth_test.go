@@ -0,0 +1,74 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecCAGR(t *testing.T) {
+	for i, tt := range []struct {
+		begin, end string
+		periods    int
+		s          inf.Scale
+		r          inf.Rounder
+		exp        string // empty if nil is expected
+	}{
+		{"100", "121", 2, 4, inf.RoundExact, "0.1000"},
+		{"100", "121", 2, 1, inf.RoundExact, "0.1"},
+		{"100", "200", 1, 2, inf.RoundExact, "1.00"},
+		{"100", "200", 2, 6, inf.RoundHalfEven, "0.414214"},
+		{"100", "200", 2, 6, inf.RoundDown, "0.414213"},
+		{"100", "200", 2, 6, inf.RoundUp, "0.414214"},
+		{"100", "200", 2, 6, inf.RoundExact, ""},
+		{"121", "100", 2, 6, inf.RoundHalfEven, "-0.090909"},
+		{"121", "100", 2, 6, inf.RoundDown, "-0.090909"},
+		{"121", "100", 2, 6, inf.RoundFloor, "-0.090910"},
+		{"121", "100", 2, 6, inf.RoundCeil, "-0.090909"},
+		{"400", "100", 2, 2, inf.RoundExact, "-0.50"},
+		{"100", "0", 3, 2, inf.RoundExact, "-1.00"},
+		{"1.5", "1.5", 7, 2, inf.RoundExact, "0.00"},
+		// 0.999999 ** (1/2) - 1 = -0.0000005000001...
+		{"1", "0.999999", 2, 6, inf.RoundHalfEven, "-0.000001"},
+		{"1", "0.999999", 2, 6, inf.RoundDown, "0.000000"},
+		{"0", "1", 1, 2, inf.RoundDown, ""},
+		{"1", "-1", 1, 2, inf.RoundDown, ""},
+		{"1", "2", 0, 2, inf.RoundDown, ""},
+		{"1", "2", 1, -1, inf.RoundDown, ""},
+	} {
+		xs := decs(tt.begin, tt.end)
+		z := inf.CAGR(xs[0], xs[1], tt.periods, tt.s, tt.r)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d CAGR(%v, %v, %d) got %v; expected nil", i, xs[0], xs[1], tt.periods, z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d CAGR(%v, %v, %d) got %v; expected %s", i, xs[0], xs[1], tt.periods, z, tt.exp)
+		}
+	}
+}
+
+func TestDecCompound(t *testing.T) {
+	for i, tt := range []struct {
+		x, rate string
+		n       int
+		exp     string // empty if nil is expected
+	}{
+		{"100", "0.1", 2, "121.00"},
+		{"100.00", "0.05", 3, "115.76250000"},
+		{"100", "-0.5", 3, "12.500"},
+		{"100", "0.1", 0, "100"},
+		{"1", "0.01", 10, "1.10462212541120451001"},
+		{"100", "0.1", -1, ""},
+	} {
+		xs := decs(tt.x, tt.rate)
+		z := inf.Compound(xs[0], xs[1], tt.n)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d Compound(%v, %v, %d) got %v; expected nil", i, xs[0], xs[1], tt.n, z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d Compound(%v, %v, %d) got %v; expected %s", i, xs[0], xs[1], tt.n, z, tt.exp)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package inf
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/big"
+)
+
+// canonical hash format version
+const decHashVersion byte = 1
+
+// reduced returns the unscaled value and scale of the representation of x
+// with all trailing zeros removed from the unscaled value; zero is
+// represented with scale 0. The returned unscaled value may be the unscaled
+// value of x, so it must not be modified.
+func (x *Dec) reduced() (*big.Int, Scale) {
+	u, s := x.UnscaledBig(), x.Scale()
+	if u.Sign() == 0 {
+		return u, 0
+	}
+	if u.Bit(0) != 0 {
+		// odd => not divisible by 10
+		return u, s
+	}
+	u = new(big.Int).Set(u)
+	q, r := new(big.Int), new(big.Int)
+	for _, n := range []Scale{16, 4, 1} {
+		e := exp10(n)
+		// the scale is kept within the range of Scale
+		for int64(s)-int64(n) >= int64(MinScale) {
+			q.QuoRem(u, e, r)
+			if r.Sign() != 0 {
+				break
+			}
+			u, q = q, u
+			s -= n
+		}
+	}
+	return u, s
+}
+
+// HashCanonical writes a canonical binary representation of the value of x
+// to h, such that mathematically equal values (such as 1.50 and 1.5) are
+// written identically, regardless of their scale. The representation is
+// stable across processes, platforms and versions of this package, so it is
+// suitable for distributed deduplication and sharding.
+//
+// The representation of x, with all trailing zeros removed from the unscaled
+// value (and with scale 0 for zero), consists of:
+//
+//	1 byte   format version (1)
+//	1 byte   sign: 0 for zero, 1 for positive, 2 for negative
+//	8 bytes  scale, as a big-endian two's complement integer
+//	8 bytes  length of the magnitude in bytes, big-endian
+//	n bytes  magnitude of the unscaled value, big-endian
+func (x *Dec) HashCanonical(h hash.Hash) {
+	u, s := x.reduced()
+	mag := u.Bytes()
+	buf := make([]byte, 18, 18+len(mag))
+	buf[0] = decHashVersion
+	switch u.Sign() {
+	case 1:
+		buf[1] = 1
+	case -1:
+		buf[1] = 2
+	}
+	binary.BigEndian.PutUint64(buf[2:], uint64(int64(s)))
+	binary.BigEndian.PutUint64(buf[10:], uint64(len(mag)))
+	h.Write(append(buf, mag...))
+}
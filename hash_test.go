@@ -0,0 +1,52 @@
+package inf_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func hashCanonical(x *inf.Dec) []byte {
+	h := sha256.New()
+	x.HashCanonical(h)
+	return h.Sum(nil)
+}
+
+var decHashCanonicalEqual = [][]*inf.Dec{
+	{inf.NewDec(0, 0), inf.NewDec(0, 5), inf.NewDec(0, -5)},
+	{inf.NewDec(15, 1), inf.NewDec(150, 2), inf.NewDec(15000000000000000, 16)},
+	{inf.NewDec(-2, 0), inf.NewDec(-20, 1), inf.NewDec(-200, 2)},
+	{inf.NewDec(1, -3), inf.NewDec(1000, 0), inf.NewDec(10000000, 4)},
+	{inf.NewDec(123456789, 0), inf.NewDec(1234567890000000000, 10)},
+}
+
+func TestDecHashCanonical(t *testing.T) {
+	var prev []byte
+	for i, xs := range decHashCanonicalEqual {
+		h0 := hashCanonical(xs[0])
+		for j, x := range xs[1:] {
+			if h := hashCanonical(x); !bytes.Equal(h, h0) {
+				t.Errorf("#%d,%d hash of %v differs from hash of %v", i, j, x, xs[0])
+			}
+		}
+		if bytes.Equal(h0, prev) {
+			t.Errorf("#%d hash of %v equals previous hash", i, xs[0])
+		}
+		prev = h0
+	}
+	// the representation is part of the API
+	h := sha256.New()
+	inf.NewDec(-1500, 3).HashCanonical(h)
+	exp := sha256.Sum256([]byte{1, 2, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1, 15})
+	if got := h.Sum(nil); !bytes.Equal(got, exp[:]) {
+		t.Errorf("hash of -1.500 got %x; expected %x", got, exp)
+	}
+	// x must not be modified
+	x := inf.NewDec(1000, 0)
+	x.HashCanonical(h)
+	if x.Cmp(inf.NewDec(1000, 0)) != 0 || x.Scale() != 0 {
+		t.Errorf("HashCanonical modified x to %v", x)
+	}
+}
@@ -0,0 +1,80 @@
+package inf
+
+import (
+	"math/big"
+)
+
+// Sinh sets z to the hyperbolic sine of x, rounded using the given Rounder to
+// the specified scale, and returns z. The result is correctly rounded.
+//
+// As sinh(x) is irrational for x other than zero, Sinh returns nil if the
+// rounder is RoundExact and x is not zero.
+func (z *Dec) Sinh(x *Dec, s Scale, r Rounder) *Dec {
+	if x.Sign() == 0 {
+		return z.Round(x, s, r)
+	}
+	ax := new(Dec).Abs(x)
+	return z.approx(s, r, 10+magnitude(ax), func(w Scale) (*big.Int, *big.Int) {
+		// with m = e**|x| - 1, sinh(|x|) = (m + m/(m+1)) / 2
+		one := exp10(w)
+		m, e := expm1Fixed(fixed(ax, w), w)
+		t := new(big.Int).Mul(m, one)
+		t.Quo(t, new(big.Int).Add(m, one))
+		t.Add(t, m)
+		t.Rsh(t, 1)
+		if x.Sign() < 0 {
+			t.Neg(t)
+		}
+		return t, e.Add(e, bigInt[2])
+	})
+}
+
+// Cosh sets z to the hyperbolic cosine of x, rounded using the given Rounder
+// to the specified scale, and returns z. The result is correctly rounded.
+//
+// As cosh(x) is irrational for x other than zero, Cosh returns nil if the
+// rounder is RoundExact and x is not zero.
+func (z *Dec) Cosh(x *Dec, s Scale, r Rounder) *Dec {
+	if x.Sign() == 0 {
+		return z.Round(NewDec(1, 0), s, r)
+	}
+	ax := new(Dec).Abs(x)
+	return z.approx(s, r, 10+magnitude(ax), func(w Scale) (*big.Int, *big.Int) {
+		// with m = e**|x| - 1, cosh(|x|) = 1 + m**2 / (2*(m+1))
+		one := exp10(w)
+		m, e := expm1Fixed(fixed(ax, w), w)
+		t := new(big.Int).Mul(m, m)
+		t.Quo(t, new(big.Int).Lsh(new(big.Int).Add(m, one), 1))
+		t.Add(t, one)
+		return t, e.Add(e, bigInt[2])
+	})
+}
+
+// Tanh sets z to the hyperbolic tangent of x, rounded using the given
+// Rounder to the specified scale, and returns z. The result is correctly
+// rounded.
+//
+// As tanh(x) is irrational for x other than zero, Tanh returns nil if the
+// rounder is RoundExact and x is not zero.
+func (z *Dec) Tanh(x *Dec, s Scale, r Rounder) *Dec {
+	if x.Sign() == 0 {
+		return z.Round(x, s, r)
+	}
+	if negligible(x, 2, s) {
+		// tanh(|x|) = 1 - 2/(e**2|x| + 1), where 2*e**-2|x| < 10**-(s+2)
+		return z.roundNearOne(x.Sign() < 0, s, r)
+	}
+	ax2 := new(Dec).Abs(x)
+	ax2.Add(ax2, ax2)
+	return z.approx(s, r, 10+magnitude(ax2), func(w Scale) (*big.Int, *big.Int) {
+		// with m = e**2|x| - 1, tanh(|x|) = m / (m+2)
+		one := exp10(w)
+		m, e := expm1Fixed(fixed(ax2, w), w)
+		t := new(big.Int).Mul(m, one)
+		t.Quo(t, new(big.Int).Add(m, new(big.Int).Lsh(one, 1)))
+		if x.Sign() < 0 {
+			t.Neg(t)
+		}
+		return t, e.Add(e, bigInt[1])
+	})
+}
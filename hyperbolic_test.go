@@ -0,0 +1,93 @@
+package inf_test
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecHyperbolic(t *testing.T) {
+	type fn func(z, x *inf.Dec, s inf.Scale, r inf.Rounder) *inf.Dec
+	fns := []struct {
+		name string
+		f    fn
+	}{
+		{"Sinh", (*inf.Dec).Sinh},
+		{"Cosh", (*inf.Dec).Cosh},
+		{"Tanh", (*inf.Dec).Tanh},
+	}
+	for i, tt := range []struct {
+		x   string
+		s   inf.Scale
+		exp [3][3]string // Sinh, Cosh, Tanh; each RoundHalfEven, RoundUp, RoundDown
+	}{
+		{"0", 2, [3][3]string{
+			{"0.00", "0.00", "0.00"}, {"1.00", "1.00", "1.00"}, {"0.00", "0.00", "0.00"}}},
+		{"0.000137", 20, [3][3]string{
+			{"0.00013700000042855883", "0.00013700000042855884", "0.00013700000042855883"},
+			{"1.00000000938450001468", "1.00000000938450001468", "1.00000000938450001467"},
+			{"0.00013699999914288234", "0.00013699999914288234", "0.00013699999914288233"}}},
+		{"1", 30, [3][3]string{
+			{"1.175201193643801456882381850596", "1.175201193643801456882381850596", "1.175201193643801456882381850595"},
+			{"1.543080634815243778477905620757", "1.543080634815243778477905620758", "1.543080634815243778477905620757"},
+			{"0.761594155955764888119458282605", "0.761594155955764888119458282605", "0.761594155955764888119458282604"}}},
+		{"-1", 10, [3][3]string{
+			{"-1.1752011936", "-1.1752011937", "-1.1752011936"},
+			{"1.5430806348", "1.5430806349", "1.5430806348"},
+			{"-0.7615941560", "-0.7615941560", "-0.7615941559"}}},
+		{"10", 5, [3][3]string{
+			{"11013.23287", "11013.23288", "11013.23287"},
+			{"11013.23292", "11013.23293", "11013.23292"},
+			{"1.00000", "1.00000", "0.99999"}}},
+		{"-0.5", 12, [3][3]string{
+			{"-0.521095305494", "-0.521095305494", "-0.521095305493"},
+			{"1.127625965206", "1.127625965207", "1.127625965206"},
+			{"-0.462117157260", "-0.462117157261", "-0.462117157260"}}},
+		{"30", 3, [3][3]string{
+			{"5343237290762.231", "5343237290762.232", "5343237290762.231"},
+			{"5343237290762.231", "5343237290762.232", "5343237290762.231"},
+			{"1.000", "1.000", "0.999"}}},
+	} {
+		x := decs(tt.x)[0]
+		for j, f := range fns {
+			for k, r := range []inf.Rounder{inf.RoundHalfEven, inf.RoundUp, inf.RoundDown} {
+				if z := f.f(new(inf.Dec), x, tt.s, r); z == nil || z.String() != tt.exp[j][k] {
+					t.Errorf("#%d/%d %s(%v, %d) got %v; expected %s", i, k, f.name, x, tt.s, z, tt.exp[j][k])
+				}
+			}
+		}
+	}
+	if z := new(inf.Dec).Cosh(inf.NewDec(0, 0), 2, inf.RoundExact); z == nil || z.String() != "1.00" {
+		t.Errorf("Cosh(0) with RoundExact got %v; expected 1.00", z)
+	}
+	if z := new(inf.Dec).Tanh(inf.NewDec(1, 0), 2, inf.RoundExact); z != nil {
+		t.Errorf("Tanh(1) with RoundExact got %v; expected nil", z)
+	}
+}
+
+func TestDecTanhLarge(t *testing.T) {
+	start := time.Now()
+	for _, tt := range []struct {
+		x          string
+		s          inf.Scale
+		even, down string
+	}{
+		{"9", 6, "1.000000", "0.999999"},
+		{"12", 6, "1.000000", "0.999999"},
+		{"1000", 3, "1.000", "0.999"},
+		{"-100000", 3, "-1.000", "-0.999"},
+		{"100000", -1, "0", "0"},
+	} {
+		x := decs(tt.x)[0]
+		if z := new(inf.Dec).Tanh(x, tt.s, inf.RoundHalfEven); z.String() != tt.even {
+			t.Errorf("Tanh(%v, %d) with RoundHalfEven got %v; expected %s", x, tt.s, z, tt.even)
+		}
+		if z := new(inf.Dec).Tanh(x, tt.s, inf.RoundDown); z.String() != tt.down {
+			t.Errorf("Tanh(%v, %d) with RoundDown got %v; expected %s", x, tt.s, z, tt.down)
+		}
+	}
+	if d := time.Since(start); d > 2*time.Second {
+		t.Errorf("Tanh of large values took %v", d)
+	}
+}
@@ -0,0 +1,72 @@
+package inf
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// An InexactError is returned by QuoExactErr (and by the other
+// error-returning operations, such as QuoErr and RoundErr, when the Rounder
+// returns nil) when the quotient can not be expressed exactly at the
+// requested scale.
+type InexactError struct {
+	// Scale is the requested scale.
+	Scale Scale
+	// Remainder is the exact difference between the quotient and its value
+	// truncated towards zero to Scale.
+	Remainder *big.Rat
+	// Finite reports whether the quotient is a finite decimal, and thus can
+	// be expressed exactly at some scale.
+	Finite bool
+	// RequiredScale is the least scale at which the quotient can be expressed
+	// exactly, if Finite is true.
+	RequiredScale Scale
+}
+
+func (e *InexactError) Error() string {
+	if e.Finite {
+		return fmt.Sprintf("inf: quotient is inexact at scale %d (remainder %s); requires scale %d",
+			e.Scale, e.Remainder.RatString(), e.RequiredScale)
+	}
+	return fmt.Sprintf("inf: quotient is inexact at scale %d (remainder %s); not a finite decimal",
+		e.Scale, e.Remainder.RatString())
+}
+
+// QuoExactErr sets z to the quotient x/y at the scale s and returns z if the
+// quotient can be expressed exactly at that scale, as QuoRound does with
+// RoundExact. Otherwise it returns an *InexactError describing the remainder
+// and the scale that would be required, and the value of z is undefined.
+// If y is zero, QuoExactErr returns ErrDivisionByZero, and it returns
+// ErrScaleOverflow or ErrDigitLimit if the quotient exceeds the limits on
+// scales and digits.
+func (z *Dec) QuoExactErr(x, y *Dec, s Scale) (zz *Dec, err error) {
+	defer recoverErr(&zz, &err)
+	if y.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+	q, rA, rB := new(Dec).QuoRem(x, y, s)
+	if rA.Sign() == 0 {
+		return z.Set(q), nil
+	}
+	return nil, inexactError(x, y, s, rA, rB)
+}
+
+// inexactError returns the *InexactError for the quotient x/y at the scale
+// s, given the remainder rA/rB (which is not zero) as returned by QuoRem.
+func inexactError(x, y *Dec, s Scale, rA, rB *big.Int) *InexactError {
+	// remainder == rA/rB * 10**-s
+	rem := new(big.Rat).SetFrac(rA, rB)
+	if s >= 0 {
+		rem.Quo(rem, new(big.Rat).SetInt(exp10(s)))
+	} else {
+		rem.Mul(rem, new(big.Rat).SetInt(exp10(-s)))
+	}
+	e := &InexactError{Scale: s, Remainder: rem}
+	d := quoRat(x, y).Denom()
+	f2, f5 := factor2(d), factor(d, bigInt[5])
+	t := new(big.Int).Exp(bigInt[5], big.NewInt(int64(f5)), nil)
+	if e.Finite = t.Lsh(t, uint(f2)).Cmp(d) == 0; e.Finite {
+		_, e.RequiredScale = new(Dec).QuoExact(x, y).reduced()
+	}
+	return e
+}
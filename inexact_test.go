@@ -0,0 +1,48 @@
+package inf_test
+
+import (
+	"math/big"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecQuoExactErr(t *testing.T) {
+	for i, tt := range []struct {
+		x, y     string
+		s        inf.Scale
+		exp      string // empty if an error is expected
+		rem      *big.Rat
+		finite   bool
+		required inf.Scale
+	}{
+		{"1", "8", 3, "0.125", nil, false, 0},
+		{"1", "8", 5, "0.12500", nil, false, 0},
+		{"1", "8", 2, "", big.NewRat(1, 200), true, 3},
+		{"-1", "8", 1, "", big.NewRat(-1, 40), true, 3},
+		{"1", "3", 2, "", big.NewRat(1, 300), false, 0},
+		{"12.5", "0.1", 0, "125", nil, false, 0},
+		{"1250", "1", -2, "", big.NewRat(50, 1), true, -1},
+		{"0.0001", "1", 2, "", big.NewRat(1, 10000), true, 4},
+	} {
+		xs := decs(tt.x, tt.y)
+		z, err := new(inf.Dec).QuoExactErr(xs[0], xs[1], tt.s)
+		if tt.exp != "" {
+			if err != nil || z.String() != tt.exp {
+				t.Errorf("#%d QuoExactErr(%v, %v, %d) got %v, %v; expected %s", i, xs[0], xs[1], tt.s, z, err, tt.exp)
+			}
+			continue
+		}
+		e, ok := err.(*inf.InexactError)
+		if !ok || z != nil {
+			t.Errorf("#%d QuoExactErr(%v, %v, %d) got %v, %v; expected *InexactError", i, xs[0], xs[1], tt.s, z, err)
+			continue
+		}
+		if e.Scale != tt.s || e.Remainder.Cmp(tt.rem) != 0 || e.Finite != tt.finite || tt.finite && e.RequiredScale != tt.required {
+			t.Errorf("#%d QuoExactErr(%v, %v, %d) got %+v", i, xs[0], xs[1], tt.s, e)
+		}
+		if e.Error() == "" {
+			t.Errorf("#%d empty error message", i)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package inf
+
+import (
+	"math/big"
+)
+
+// AddInt64 sets z to the sum x+y and returns z. The scale of z is the scale
+// of x, or 0 if it is negative.
+func (z *Dec) AddInt64(x *Dec, y int64) *Dec {
+	var yy big.Int
+	yy.SetInt64(y)
+	return z.addInt(x, &yy)
+}
+
+// SubInt64 sets z to the difference x-y and returns z. The scale of z is the
+// scale of x, or 0 if it is negative.
+func (z *Dec) SubInt64(x *Dec, y int64) *Dec {
+	var yy big.Int
+	yy.SetInt64(y)
+	return z.addInt(x, yy.Neg(&yy))
+}
+
+// addInt sets z to x+y for the integer y, and returns z.
+func (z *Dec) addInt(x *Dec, y *big.Int) *Dec {
+	s := x.Scale()
+	u := z.UnscaledBig()
+	switch {
+	case s > 0:
+		y.Mul(y, exp10(s))
+		u.Add(x.UnscaledBig(), y)
+	case s < 0:
+		u.Mul(x.UnscaledBig(), exp10(-s))
+		u.Add(u, y)
+		s = 0
+	default:
+		u.Add(x.UnscaledBig(), y)
+	}
+	return z.SetScale(s)
+}
+
+// MulInt64 sets z to the product x*y and returns z. The scale of z is the
+// scale of x.
+func (z *Dec) MulInt64(x *Dec, y int64) *Dec {
+	var yy big.Int
+	yy.SetInt64(y)
+	z.UnscaledBig().Mul(x.UnscaledBig(), &yy)
+	return z.SetScale(x.Scale())
+}
+
+// QuoInt64 sets z to the quotient x/y, rounded using the given Rounder to the
+// specified scale, and returns z.
+//
+// If the rounder is RoundExact but the result can not be expressed exactly at
+// the specified scale, QuoInt64 returns nil, and the value of z is undefined.
+func (z *Dec) QuoInt64(x *Dec, y int64, s Scale, r Rounder) *Dec {
+	var yy Dec
+	yy.SetUnscaled(y)
+	return z.QuoRound(x, &yy, s, r)
+}
@@ -0,0 +1,69 @@
+package inf_test
+
+import (
+	"math"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecInt64Arith(t *testing.T) {
+	for i, tt := range []struct {
+		x             string
+		y             int64
+		add, sub, mul string
+	}{
+		{"1.25", 3, "4.25", "-1.75", "3.75"},
+		{"-1.25", -3, "-4.25", "1.75", "3.75"},
+		{"10", 0, "10", "10", "0"},
+		{"0.001", 100, "100.001", "-99.999", "0.100"},
+		{"5", math.MinInt64, "-9223372036854775803", "9223372036854775813", "-46116860184273879040"},
+	} {
+		x := decs(tt.x)[0]
+		if z := new(inf.Dec).AddInt64(x, tt.y); z.String() != tt.add {
+			t.Errorf("#%d AddInt64(%v, %d) got %v; expected %s", i, x, tt.y, z, tt.add)
+		}
+		if z := new(inf.Dec).SubInt64(x, tt.y); z.String() != tt.sub {
+			t.Errorf("#%d SubInt64(%v, %d) got %v; expected %s", i, x, tt.y, z, tt.sub)
+		}
+		if z := new(inf.Dec).MulInt64(x, tt.y); z.String() != tt.mul {
+			t.Errorf("#%d MulInt64(%v, %d) got %v; expected %s", i, x, tt.y, z, tt.mul)
+		}
+		// aliased
+		if z := new(inf.Dec).Set(x); z.AddInt64(z, tt.y).String() != tt.add {
+			t.Errorf("#%d AddInt64(z, %d) with z = %v got %v; expected %s", i, tt.y, x, z, tt.add)
+		}
+	}
+	// negative scales
+	if z := new(inf.Dec).AddInt64(inf.NewDec(12, -2), 34); z.String() != "1234" || z.Scale() != 0 {
+		t.Errorf("AddInt64(1200 with scale -2, 34) got %v (scale %d); expected 1234 (scale 0)", z, z.Scale())
+	}
+	if z := new(inf.Dec).MulInt64(inf.NewDec(12, -2), 3); z.String() != "3600" || z.Scale() != -2 {
+		t.Errorf("MulInt64(1200 with scale -2, 3) got %v (scale %d); expected 3600 (scale -2)", z, z.Scale())
+	}
+}
+
+func TestDecQuoInt64(t *testing.T) {
+	for i, tt := range []struct {
+		x   string
+		y   int64
+		s   inf.Scale
+		r   inf.Rounder
+		exp string // empty if nil is expected
+	}{
+		{"1234", 100, 2, inf.RoundExact, "12.34"},
+		{"10.00", 3, 2, inf.RoundHalfEven, "3.33"},
+		{"-10.00", 3, 2, inf.RoundFloor, "-3.34"},
+		{"10", 3, 2, inf.RoundExact, ""},
+	} {
+		x := decs(tt.x)[0]
+		z := new(inf.Dec).QuoInt64(x, tt.y, tt.s, tt.r)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d QuoInt64(%v, %d) got %v; expected nil", i, x, tt.y, z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d QuoInt64(%v, %d) got %v; expected %s", i, x, tt.y, z, tt.exp)
+		}
+	}
+}
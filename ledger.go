@@ -0,0 +1,91 @@
+package inf
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// A Balance sums ledger entries exactly at a fixed scale, to check that they
+// balance (sum to zero) or sum to a control total. Entries with the scale of
+// the Balance are added without allocation.
+type Balance struct {
+	scale Scale
+	sum   big.Int
+	tmp   big.Int
+	rem   big.Int
+	n     int
+}
+
+// NewBalance returns a new empty Balance with the scale s.
+func NewBalance(s Scale) *Balance {
+	return &Balance{scale: s}
+}
+
+// Add adds the entry x to b. It returns an error, and leaves b unchanged, if
+// x can not be represented with the scale of b without rounding.
+func (b *Balance) Add(x *Dec) error {
+	switch d := checkScale(int64(b.scale) - int64(x.Scale())); {
+	case d == 0:
+		b.sum.Add(&b.sum, x.UnscaledBig())
+	case d > 0:
+		b.tmp.Mul(x.UnscaledBig(), exp10(d))
+		b.sum.Add(&b.sum, &b.tmp)
+	default:
+		b.tmp.QuoRem(x.UnscaledBig(), exp10(-d), &b.rem)
+		if b.rem.Sign() != 0 {
+			return fmt.Errorf("Balance.Add: entry %d (%v) has more than %d fraction digits", b.n, x, b.scale)
+		}
+		b.sum.Add(&b.sum, &b.tmp)
+	}
+	b.n++
+	return nil
+}
+
+// Len returns the number of entries added to b.
+func (b *Balance) Len() int {
+	return b.n
+}
+
+// Sum returns the sum of the entries added to b, with the scale of b.
+func (b *Balance) Sum() *Dec {
+	return NewDecBig(new(big.Int).Set(&b.sum), b.scale)
+}
+
+// Check returns nil if the entries added to b sum to exactly control, or to
+// zero if control is nil. Otherwise it returns an *ImbalanceError with the
+// exact discrepancy.
+func (b *Balance) Check(control *Dec) error {
+	d := b.Sum()
+	if control != nil {
+		d.Sub(d, control)
+	}
+	if d.Sign() == 0 {
+		return nil
+	}
+	return &ImbalanceError{Discrepancy: d}
+}
+
+// An ImbalanceError is returned by Balance.Check and CheckBalance if the
+// entries do not sum to the expected total.
+type ImbalanceError struct {
+	// Discrepancy is the sum of the entries minus the expected total.
+	Discrepancy *Dec
+}
+
+func (e *ImbalanceError) Error() string {
+	return fmt.Sprintf("inf: entries do not balance; discrepancy %v", e.Discrepancy)
+}
+
+// CheckBalance checks that the entries sum to exactly control, or to zero if
+// control is nil, as by adding them to a Balance with the scale s. It returns
+// an *ImbalanceError with the exact discrepancy if they do not, and another
+// error if an entry can not be represented with the scale s.
+func CheckBalance(entries []*Dec, control *Dec, s Scale) error {
+	b := NewBalance(s)
+	for _, x := range entries {
+		if err := b.Add(x); err != nil {
+			return err
+		}
+	}
+	return b.Check(control)
+}
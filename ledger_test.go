@@ -0,0 +1,60 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecCheckBalance(t *testing.T) {
+	for i, tt := range []struct {
+		entries     []*inf.Dec
+		control     *inf.Dec
+		discrepancy string // empty if balanced, "error" for other errors
+	}{
+		{nil, nil, ""},
+		{decs("100.00", "-60.00", "-40.00"), nil, ""},
+		{decs("100", "-60.5", "-39.50"), nil, ""},
+		{decs("100.00", "-60.00", "-39.99"), nil, "0.01"},
+		{decs("100.00", "-60.00", "-40.01"), nil, "-0.01"},
+		{decs("100.00", "20.00"), inf.NewDec(120, 0), ""},
+		{decs("100.00", "20.00"), inf.NewDec(12001, 2), "-0.01"},
+		{[]*inf.Dec{inf.NewDec(10000, 2), inf.NewDec(1, -2)}, inf.NewDec(200, 0), ""},
+		{decs("100.00", "-100.001"), nil, "error"},
+		{decs("100.00", "-100.000"), nil, ""},
+	} {
+		err := inf.CheckBalance(tt.entries, tt.control, 2)
+		switch tt.discrepancy {
+		case "":
+			if err != nil {
+				t.Errorf("#%d CheckBalance got %v; expected balance", i, err)
+			}
+		case "error":
+			if _, ok := err.(*inf.ImbalanceError); err == nil || ok {
+				t.Errorf("#%d CheckBalance got %v; expected entry error", i, err)
+			}
+		default:
+			e, ok := err.(*inf.ImbalanceError)
+			if !ok || e.Discrepancy.String() != tt.discrepancy {
+				t.Errorf("#%d CheckBalance got %v; expected discrepancy %s", i, err, tt.discrepancy)
+			}
+		}
+	}
+}
+
+func TestDecBalanceAllocs(t *testing.T) {
+	b := inf.NewBalance(2)
+	x, y := inf.NewDec(12345, 2), inf.NewDec(-1, 0)
+	b.Add(x)
+	b.Add(y)
+	if n := testing.AllocsPerRun(100, func() {
+		b.Add(x)
+		b.Add(y)
+	}); n > 0 {
+		t.Errorf("Balance.Add allocated %v times; expected 0", n)
+	}
+	// AllocsPerRun runs f once more as a warm-up
+	if b.Len() != 204 || b.Sum().String() != "12489.90" {
+		t.Errorf("Balance got %d entries, sum %v", b.Len(), b.Sum())
+	}
+}
@@ -0,0 +1,104 @@
+package inf
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ArabicDecimalSeparator is the Arabic decimal separator (U+066B), accepted
+// as the decimal point by a LenientParser with UnicodeDigits set.
+const ArabicDecimalSeparator = '٫'
+
+// A LenientParser parses decimals from user input, which is more permissive
+// than the format accepted by SetString:
+//
+//   - leading and trailing white space is ignored;
+//   - if GroupSep is not 0, it is ignored between digits of the integer part;
+//   - if UnicodeDigits is true, decimal digits of any script (the characters
+//     in unicode.Digit, such as Arabic-Indic or full-width digits) are
+//     accepted, and ArabicDecimalSeparator is accepted as the decimal point.
+//
+// The scale of the result is the number of digits after the decimal point,
+// as for SetString. The zero value is a valid LenientParser that only ignores
+// surrounding white space.
+type LenientParser struct {
+	GroupSep      rune
+	UnicodeDigits bool
+}
+
+// Parse parses s and returns the resulting Dec, or an error if s is not a
+// valid decimal.
+func (p *LenientParser) Parse(s string) (*Dec, error) {
+	t := strings.TrimSpace(s)
+	digits := make([]byte, 0, len(t))
+	neg, dp, prev := false, -1, rune(0)
+	for i, w := 0, 0; i < len(t); i += w {
+		ch := rune(t[i])
+		w = 1
+		if ch >= utf8.RuneSelf {
+			ch, w = utf8.DecodeRuneInString(t[i:])
+		}
+		switch {
+		case i == 0 && (ch == '+' || ch == '-'):
+			neg = ch == '-'
+		case ch >= '0' && ch <= '9':
+			digits = append(digits, byte(ch))
+		case ch == '.' || p.UnicodeDigits && ch == ArabicDecimalSeparator:
+			if dp >= 0 {
+				return nil, fmt.Errorf("LenientParser.Parse: invalid decimal %q", s)
+			}
+			dp = len(digits)
+		case ch == p.GroupSep && ch != 0 && dp < 0 && isDigit(prev, p.UnicodeDigits) &&
+			i+w < len(t) && isDigit(firstRune(t[i+w:]), p.UnicodeDigits):
+			// ignored
+		case p.UnicodeDigits && ch >= utf8.RuneSelf && unicode.Is(unicode.Digit, ch):
+			digits = append(digits, byte('0'+digitValue(ch)))
+		default:
+			return nil, fmt.Errorf("LenientParser.Parse: invalid decimal %q", s)
+		}
+		prev = ch
+	}
+	if len(digits) == 0 {
+		return nil, fmt.Errorf("LenientParser.Parse: invalid decimal %q", s)
+	}
+	if dp >= 0 && !validScale(int64(len(digits)-dp)) {
+		return nil, ErrScaleOverflow
+	}
+	if digitLimitExceeded(int64(len(digits))) {
+		return nil, ErrDigitLimit
+	}
+	z := new(Dec).setDigits(neg, digits)
+	if dp >= 0 {
+		return z.SetScale(Scale(len(digits) - dp)), nil
+	}
+	return z, nil
+}
+
+func firstRune(s string) rune {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r
+}
+
+func isDigit(r rune, unicodeDigits bool) bool {
+	return r >= '0' && r <= '9' || unicodeDigits && unicode.Is(unicode.Digit, r)
+}
+
+// digitValue returns the value of the decimal digit r (in unicode.Digit).
+// The decimal digits of each script are encoded contiguously, from 0 to 9,
+// so that each range of unicode.Digit consists of whole sequences of ten
+// digits.
+func digitValue(r rune) int {
+	for _, rg := range unicode.Digit.R16 {
+		if r >= rune(rg.Lo) && r <= rune(rg.Hi) {
+			return int(r-rune(rg.Lo)) % 10
+		}
+	}
+	for _, rg := range unicode.Digit.R32 {
+		if r >= rune(rg.Lo) && r <= rune(rg.Hi) {
+			return int(r-rune(rg.Lo)) % 10
+		}
+	}
+	return -1
+}
@@ -0,0 +1,52 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecLenientParser(t *testing.T) {
+	ascii := &inf.LenientParser{}
+	grouped := &inf.LenientParser{GroupSep: ','}
+	uni := &inf.LenientParser{GroupSep: '٬', UnicodeDigits: true}
+	for i, tt := range []struct {
+		p   *inf.LenientParser
+		in  string
+		out string // empty if an error is expected
+	}{
+		{ascii, " 1.50\n", "1.50"},
+		{ascii, "-12", "-12"},
+		{ascii, "+.5", "0.5"},
+		{ascii, "1,000", ""},
+		{ascii, "١٢", ""},
+		{ascii, "", ""},
+		{ascii, "-", ""},
+		{ascii, "1.2.3", ""},
+		{grouped, "1,234,567.89", "1234567.89"},
+		{grouped, "-1,000", "-1000"},
+		{grouped, ",100", ""},
+		{grouped, "100,", ""},
+		{grouped, "1,,000", ""},
+		{grouped, "1.000,5", ""},
+		{uni, "١٢٣٫٤٥", "123.45"},
+		{uni, "-١٬٢٣٤", "-1234"},
+		{uni, "۱۲٫۵", "12.5"},
+		{uni, "１２３.４", "123.4"},
+		{uni, "१२३", "123"},
+		{uni, "𝟗𝟖", "98"},
+		{uni, "12٫5", "12.5"},
+		{uni, "½", ""},
+	} {
+		z, err := tt.p.Parse(tt.in)
+		if tt.out == "" {
+			if err == nil {
+				t.Errorf("#%d Parse(%q) got %v; expected error", i, tt.in, z)
+			}
+			continue
+		}
+		if err != nil || z.String() != tt.out {
+			t.Errorf("#%d Parse(%q) got %v, %v; expected %s", i, tt.in, z, err, tt.out)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package inf
+
+// MaxDigits limits the sizes of values read from input and of intermediate
+// values, to protect against excessive memory and CPU usage with untrusted
+// input (such as a value with the scale -2000000000, which has a small
+// representation but 2000000000 digits as an integer). When MaxDigits is
+// positive:
+//
+//   - SetString, ParseDec, Scan, UnmarshalText, SetStringScale, ListScanner
+//     and LenientParser reject inputs with more than MaxDigits digits, and
+//     GobDecode, SetDynamoDBNumber and decfmt.ParseDec (which accept
+//     exponents) reject values with more than MaxDigits digits or a scale
+//     greater than MaxDigits in absolute value;
+//   - operations that would need a power of ten 10**n with n greater than
+//     MaxDigits to align or rescale their operands (such as Add, Quo and
+//     Round with operands of very different scales) panic with
+//     ErrDigitLimit; the error-returning variants (such as QuoErr) return it
+//     instead. Powers up to 10**63 are precomputed, and are always allowed.
+//
+// MaxDigits is 0 (no limit) by default. As it is not safe to change it
+// concurrently with operations, it should be set before use, such as in an
+// init function.
+var MaxDigits int
+
+// digitLimitExceeded reports whether n digits exceed MaxDigits.
+func digitLimitExceeded(n int64) bool {
+	return MaxDigits > 0 && n > int64(MaxDigits)
+}
+
+// checkDigitLimit returns ErrDigitLimit if the digits or the scale of x
+// exceed MaxDigits, and nil otherwise.
+func (x *Dec) checkDigitLimit() error {
+	s := int64(x.Scale())
+	if s < 0 {
+		s = -s
+	}
+	if digitLimitExceeded(s) || digitLimitExceeded(int64(numDigits(x.UnscaledBig()))) {
+		return ErrDigitLimit
+	}
+	return nil
+}
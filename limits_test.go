@@ -0,0 +1,73 @@
+package inf_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"strings"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestMaxDigits(t *testing.T) {
+	defer func(n int) { inf.MaxDigits = n }(inf.MaxDigits)
+	inf.MaxDigits = 10
+
+	if _, ok := new(inf.Dec).SetString("-12345.67890"); !ok {
+		t.Errorf("SetString with 10 digits failed")
+	}
+	if z, ok := new(inf.Dec).SetString("123456.78901"); ok {
+		t.Errorf("SetString with 11 digits got %v; expected failure", z)
+	}
+	if _, err := new(inf.Dec).SetStringScale("1"+strings.Repeat("0", 10), 0, inf.RoundDown); err == nil {
+		t.Errorf("SetStringScale with 11 digits succeeded")
+	}
+	if _, err := (&inf.LenientParser{}).Parse("0.00000000001"); err != inf.ErrDigitLimit {
+		t.Errorf("LenientParser.Parse with 12 digits got error %v; expected ErrDigitLimit", err)
+	}
+	if _, err := inf.ParseDec("123456.78901"); err != inf.ErrDigitLimit {
+		t.Errorf("ParseDec with 11 digits got error %v; expected ErrDigitLimit", err)
+	}
+	if _, err := inf.ParseDec("1.2.3"); err == nil || err == inf.ErrDigitLimit {
+		t.Errorf("ParseDec(1.2.3) got error %v; expected a syntax error", err)
+	}
+	for _, s := range []string{"0e50000000", "1e-11", "12345678901"} {
+		if _, err := new(inf.Dec).SetDynamoDBNumber(s); err != inf.ErrDigitLimit {
+			t.Errorf("SetDynamoDBNumber(%q) got error %v; expected ErrDigitLimit", s, err)
+		}
+	}
+
+	// GobDecode rejects large scales as well as many digits
+	for _, x := range []*inf.Dec{inf.NewDec(1, -11), inf.NewDec(1, 11), inf.NewDec(12345678901, 0)} {
+		var buf bytes.Buffer
+		inf.MaxDigits = 0
+		if err := gob.NewEncoder(&buf).Encode(x); err != nil {
+			t.Fatal(err)
+		}
+		inf.MaxDigits = 10
+		if err := gob.NewDecoder(&buf).Decode(new(inf.Dec)); err != inf.ErrDigitLimit {
+			t.Errorf("GobDecode(%v) got error %v; expected ErrDigitLimit", x, err)
+		}
+	}
+
+	// intermediate values
+	x, y := inf.NewDec(1, -100), inf.NewDec(3, 0)
+	if _, err := new(inf.Dec).QuoErr(x, y, inf.ScaleFixed(0), inf.RoundDown); err != inf.ErrDigitLimit {
+		t.Errorf("QuoErr(%v, %v) got error %v; expected ErrDigitLimit", x, y, err)
+	}
+	if _, err := new(inf.Dec).RoundErr(x, 0, inf.RoundDown); err != inf.ErrDigitLimit {
+		t.Errorf("RoundErr(%v) got error %v; expected ErrDigitLimit", x, err)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r != inf.ErrDigitLimit {
+				t.Errorf("Add(%v, %v) got panic %v; expected ErrDigitLimit", x, y, r)
+			}
+		}()
+		new(inf.Dec).Add(x, y)
+	}()
+	// operands with equal scales need no rescaling
+	if z := new(inf.Dec).Add(x, inf.NewDec(2, -100)); z.Cmp(inf.NewDec(3, -100)) != 0 {
+		t.Errorf("Add got %v; expected 3E+100", z)
+	}
+}
@@ -0,0 +1,79 @@
+//go:build go1.18
+// +build go1.18
+
+package inf
+
+// Num represents the arithmetic operations on values of type T needed by
+// generic algorithms (such as aggregation and statistics), so that the same
+// generic code can be instantiated with *Dec as well as with built-in numeric
+// types:
+//
+//	func Sum[T any](n inf.Num[T], xs []T) T {
+//		s := n.Zero()
+//		for _, x := range xs {
+//			s = n.Add(s, x)
+//		}
+//		return s
+//	}
+//
+//	Sum[*inf.Dec](inf.DecNum{}, decs)
+//	Sum[float64](inf.BuiltinNum[float64]{}, floats)
+type Num[T any] interface {
+	// Zero returns the zero value.
+	Zero() T
+	// Add returns the sum x+y.
+	Add(x, y T) T
+	// Mul returns the product x*y.
+	Mul(x, y T) T
+	// Cmp compares x and y and returns -1, 0 or +1 if x is less than, equal
+	// to, or greater than y, respectively.
+	Cmp(x, y T) int
+}
+
+// DecNum implements Num for *Dec. Each result is a newly allocated Dec; the
+// operands are not modified.
+type DecNum struct{}
+
+// Zero returns a new Dec with the value 0 and scale 0.
+func (DecNum) Zero() *Dec { return new(Dec) }
+
+// Add returns x+y as a new Dec.
+func (DecNum) Add(x, y *Dec) *Dec { return new(Dec).Add(x, y) }
+
+// Mul returns x*y as a new Dec.
+func (DecNum) Mul(x, y *Dec) *Dec { return new(Dec).Mul(x, y) }
+
+// Cmp returns x.Cmp(y).
+func (DecNum) Cmp(x, y *Dec) int { return x.Cmp(y) }
+
+type builtinNumber interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// BuiltinNum implements Num for the built-in integer and floating-point
+// types.
+type BuiltinNum[T builtinNumber] struct{}
+
+// Zero returns 0.
+func (BuiltinNum[T]) Zero() T { return 0 }
+
+// Add returns x+y.
+func (BuiltinNum[T]) Add(x, y T) T { return x + y }
+
+// Mul returns x*y.
+func (BuiltinNum[T]) Mul(x, y T) T { return x * y }
+
+// Cmp compares x and y.
+func (BuiltinNum[T]) Cmp(x, y T) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return +1
+	}
+	return 0
+}
+
+var _ Num[*Dec] = DecNum{}
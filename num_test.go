@@ -0,0 +1,45 @@
+//go:build go1.18
+// +build go1.18
+
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func sumProducts[T any](n inf.Num[T], xs, ys []T) T {
+	s := n.Zero()
+	for i := range xs {
+		s = n.Add(s, n.Mul(xs[i], ys[i]))
+	}
+	return s
+}
+
+func maxOf[T any](n inf.Num[T], xs []T) T {
+	m := xs[0]
+	for _, x := range xs[1:] {
+		if n.Cmp(x, m) > 0 {
+			m = x
+		}
+	}
+	return m
+}
+
+func TestDecNum(t *testing.T) {
+	xs := []*inf.Dec{inf.NewDec(15, 1), inf.NewDec(-2, 0), inf.NewDec(25, 2)}
+	ys := []*inf.Dec{inf.NewDec(2, 0), inf.NewDec(3, 1), inf.NewDec(4, 0)}
+	if s := sumProducts[*inf.Dec](inf.DecNum{}, xs, ys); s.String() != "3.40" {
+		t.Errorf("sumProducts got %v; expected 3.40", s)
+	}
+	if m := maxOf[*inf.Dec](inf.DecNum{}, xs); m != xs[0] {
+		t.Errorf("maxOf got %v; expected %v", m, xs[0])
+	}
+	if s := sumProducts[int64](inf.BuiltinNum[int64]{}, []int64{1, 2, 3}, []int64{4, 5, 6}); s != 32 {
+		t.Errorf("sumProducts got %v; expected 32", s)
+	}
+	if m := maxOf[float64](inf.BuiltinNum[float64]{}, []float64{-1, 2.5, 0}); m != 2.5 {
+		t.Errorf("maxOf got %v; expected 2.5", m)
+	}
+}
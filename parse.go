@@ -0,0 +1,190 @@
+package inf
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// maxInt64Digits is the number of decimal digits that always fit in an int64.
+const maxInt64Digits = 18
+
+// setBytes sets z to the value of b, interpreted as a decimal in the format
+// accepted by SetString, using buf as scratch space. It returns z and the
+// (possibly grown) buf, or nil if b is not a valid decimal.
+func (z *Dec) setBytes(b []byte, buf []byte) (*Dec, []byte) {
+	buf = buf[:0]
+	i, neg := 0, false
+	if len(b) > 0 && (b[0] == '+' || b[0] == '-') {
+		neg = b[0] == '-'
+		i++
+	}
+	dp := -1
+	for ; i < len(b); i++ {
+		switch ch := b[i]; {
+		case ch >= '0' && ch <= '9':
+			buf = append(buf, ch)
+		case ch == '.' && dp < 0:
+			dp = len(buf)
+		default:
+			return nil, buf
+		}
+	}
+	if len(buf) == 0 || digitLimitExceeded(int64(len(buf))) ||
+		dp >= 0 && !validScale(int64(len(buf)-dp)) {
+		return nil, buf
+	}
+	if dp >= 0 {
+		z.SetScale(Scale(len(buf) - dp))
+	} else {
+		z.SetScale(0)
+	}
+	z.setDigits(neg, buf)
+	return z, buf
+}
+
+// setDigits sets the unscaled value of z to the value of the ASCII decimal
+// digits in digits, negated if neg is true, and returns z.
+func (z *Dec) setDigits(neg bool, digits []byte) *Dec {
+	if len(digits) <= maxInt64Digits {
+		var u int64
+		for _, ch := range digits {
+			u = u*10 + int64(ch-'0')
+		}
+		z.SetUnscaled(u)
+	} else {
+		z.UnscaledBig().SetString(string(digits), 10)
+	}
+	if neg {
+		z.UnscaledBig().Neg(z.UnscaledBig())
+	}
+	return z
+}
+
+// A ListScanner reads a sequence of decimals separated by a delimiter byte,
+// such as a line of comma-separated values or a file with one value per
+// line. Whitespace surrounding each value is ignored. A delimiter at the end
+// of the input (such as a final newline) terminates the last value rather
+// than starting a new one; any empty value (such as between two consecutive
+// delimiters) is an error. Each value must be in the format accepted by
+// SetString.
+//
+// Successive calls to Scan step through the values, reusing the same buffers
+// for each of them.
+type ListScanner struct {
+	s   *bufio.Scanner
+	dec Dec
+	buf []byte
+	n   int
+	err error
+}
+
+// NewListScanner returns a new ListScanner reading values separated by sep
+// from r.
+func NewListScanner(r io.Reader, sep byte) *ListScanner {
+	s := bufio.NewScanner(r)
+	s.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.IndexByte(data, sep); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	})
+	return &ListScanner{s: s}
+}
+
+// Scan advances the ListScanner to the next value, which will then be
+// available through the Dec method. It returns false when the scan stops,
+// either by reaching the end of the input or an error.
+func (ls *ListScanner) Scan() bool {
+	if ls.err != nil {
+		return false
+	}
+	if !ls.s.Scan() {
+		ls.err = ls.s.Err()
+		return false
+	}
+	tok := bytes.TrimSpace(ls.s.Bytes())
+	if len(tok) == 0 {
+		ls.err = fmt.Errorf("ListScanner.Scan: empty value at index %d", ls.n)
+		return false
+	}
+	var z *Dec
+	z, ls.buf = ls.dec.setBytes(tok, ls.buf)
+	if z == nil {
+		ls.err = fmt.Errorf("ListScanner.Scan: invalid decimal %q at index %d", tok, ls.n)
+		return false
+	}
+	ls.n++
+	return true
+}
+
+// Dec returns the value read by the most recent call to Scan. The returned
+// Dec is reused by the ListScanner, and is overwritten by the next call to
+// Scan.
+func (ls *ListScanner) Dec() *Dec {
+	return &ls.dec
+}
+
+// Err returns the first error encountered by the ListScanner, or nil if the
+// end of the input was reached without errors.
+func (ls *ListScanner) Err() error {
+	return ls.err
+}
+
+// ParseList reads all decimals separated by sep from r, as by ListScanner,
+// and returns them. It returns the values read so far and an error if the
+// input contains an invalid value or reading from r fails.
+func ParseList(r io.Reader, sep byte) ([]Dec, error) {
+	var ds []Dec
+	ls := NewListScanner(r, sep)
+	for ls.Scan() {
+		ds = append(ds, Dec{})
+		ds[len(ds)-1].Set(ls.Dec())
+	}
+	return ds, ls.Err()
+}
+
+// SetStringScale sets z to the value of s, interpreted as a decimal in the
+// format accepted by SetString, rounded to scale using r, and returns z. This
+// is useful for parsing input into a field with a fixed scale, such as an
+// amount of money.
+//
+// If s is not a valid decimal, or r is RoundExact and the value of s can not
+// be represented with scale exactly, SetStringScale returns nil and an error
+// describing the input; the value of z is undefined in that case.
+func (z *Dec) SetStringScale(s string, scale Scale, r Rounder) (*Dec, error) {
+	return z.setStringScale("SetStringScale", s, scale, r)
+}
+
+// setStringScale implements SetStringScale; op is the name of the calling
+// method, for errors.
+func (z *Dec) setStringScale(op, s string, scale Scale, r Rounder) (*Dec, error) {
+	if d, _ := z.setBytes([]byte(s), nil); d == nil {
+		return nil, fmt.Errorf("Dec.%s: invalid decimal %q", op, s)
+	}
+	if z.Scale() == scale {
+		return z, nil
+	}
+	if z.Round(z, scale, r) == nil {
+		return nil, fmt.Errorf("Dec.%s: %q can not be represented with scale %d", op, s, scale)
+	}
+	return z, nil
+}
+
+// SetStringNumeric is like SetStringScale, but additionally fails if the
+// rounded value has more than prec digits; that is, if its integer part has
+// more than prec-scale digits. This corresponds to the validation of values
+// of the SQL type NUMERIC(prec, scale).
+func (z *Dec) SetStringNumeric(s string, prec int, scale Scale, r Rounder) (*Dec, error) {
+	if _, err := z.setStringScale("SetStringNumeric", s, scale, r); err != nil {
+		return nil, err
+	}
+	if numDigits(z.UnscaledBig()) > prec {
+		return nil, fmt.Errorf("Dec.SetStringNumeric: %q overflows precision %d with scale %d", s, prec, scale)
+	}
+	return z, nil
+}
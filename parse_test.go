@@ -0,0 +1,112 @@
+package inf_test
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decParseListTests = []struct {
+	in  string
+	sep byte
+	out []string
+	ok  bool
+}{
+	{"", ',', nil, true},
+	{"1", ',', []string{"1"}, true},
+	{"1,2.50,-0.3", ',', []string{"1", "2.50", "-0.3"}, true},
+	{" 1 , +2. ,.5 \n", ',', []string{"1", "2", "0.5"}, true},
+	{"1.5\n-2.25\r\n3\n", '\n', []string{"1.5", "-2.25", "3"}, true},
+	{"1,2,", ',', []string{"1", "2"}, true},
+	{"123456789012345678901234567890.123", ';', []string{"123456789012345678901234567890.123"}, true},
+	{"-123456789012345678", ';', []string{"-123456789012345678"}, true},
+	{"1,", ',', []string{"1"}, true},
+	{"1,,2", ',', []string{"1"}, false},
+	{"1,,", ',', []string{"1"}, false},
+	{",1", ',', nil, false},
+	{"1, ", ',', []string{"1"}, false},
+	{"1,x,2", ',', []string{"1"}, false},
+	{"1,1.2.3", ',', []string{"1"}, false},
+	{"1,-", ',', []string{"1"}, false},
+	{"1,2 3", ',', []string{"1"}, false},
+}
+
+func TestDecParseList(t *testing.T) {
+	for i, tt := range decParseListTests {
+		ds, err := inf.ParseList(strings.NewReader(tt.in), tt.sep)
+		if (err == nil) != tt.ok {
+			t.Errorf("#%d ParseList(%q) got error %v; expected ok %v", i, tt.in, err, tt.ok)
+		}
+		if len(ds) != len(tt.out) {
+			t.Errorf("#%d ParseList(%q) got %d values; expected %d", i, tt.in, len(ds), len(tt.out))
+			continue
+		}
+		for j := range ds {
+			if s := ds[j].String(); s != tt.out[j] {
+				t.Errorf("#%d,%d ParseList(%q) got %s; expected %s", i, j, tt.in, s, tt.out[j])
+			}
+		}
+	}
+}
+
+func TestDecParseListSetString(t *testing.T) {
+	for i, test := range decStringTests {
+		// an empty input is a valid empty list
+		if test.scale < 0 || test.in == "" {
+			continue
+		}
+		ds, err := inf.ParseList(strings.NewReader(test.in), ',')
+		ok := err == nil && len(ds) == 1
+		if ok != test.ok {
+			t.Errorf("#%d (input '%s') ok incorrect (should be %t)", i, test.in, test.ok)
+			continue
+		}
+		if ok && ds[0].Cmp(inf.NewDec(test.val, test.scale)) != 0 {
+			t.Errorf("#%d (input '%s') got: %s want: %d", i, test.in, &ds[0], test.val)
+		}
+	}
+}
+
+func TestDecSetStringScale(t *testing.T) {
+	for i, tt := range []struct {
+		in    string
+		prec  int // 0: SetStringScale
+		scale inf.Scale
+		r     inf.Rounder
+		out   string // empty if an error is expected
+	}{
+		{"1.5", 0, 2, inf.RoundExact, "1.50"},
+		{"1.50", 0, 2, inf.RoundExact, "1.50"},
+		{"-1.005", 0, 2, inf.RoundHalfEven, "-1.00"},
+		{"-1.015", 0, 2, inf.RoundHalfEven, "-1.02"},
+		{"1.005", 0, 2, inf.RoundExact, ""},
+		{"1.000", 0, 2, inf.RoundExact, "1.00"},
+		{"1234", 0, -2, inf.RoundDown, "1200"},
+		{"1.2.3", 0, 2, inf.RoundExact, ""},
+		{"", 0, 2, inf.RoundExact, ""},
+		{"999.99", 5, 2, inf.RoundExact, "999.99"},
+		{"-999.99", 5, 2, inf.RoundExact, "-999.99"},
+		{"1000", 5, 2, inf.RoundExact, ""},
+		{"999.995", 5, 2, inf.RoundHalfUp, ""},
+		{"999.994", 5, 2, inf.RoundHalfUp, "999.99"},
+		{"0.001", 2, 2, inf.RoundExact, ""},
+	} {
+		var z *inf.Dec
+		var err error
+		if tt.prec == 0 {
+			z, err = new(inf.Dec).SetStringScale(tt.in, tt.scale, tt.r)
+		} else {
+			z, err = new(inf.Dec).SetStringNumeric(tt.in, tt.prec, tt.scale, tt.r)
+		}
+		if tt.out == "" {
+			if z != nil || err == nil {
+				t.Errorf("#%d %q got %v, %v; expected error", i, tt.in, z, err)
+			}
+			continue
+		}
+		if err != nil || z.String() != tt.out || z.Scale() != tt.scale {
+			t.Errorf("#%d %q got %v, %v; expected %s", i, tt.in, z, err, tt.out)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package inf
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const (
+	// ISO20022MaxDigits is the maximum number of digits (totalDigits) of an
+	// amount in ISO 20022 messages.
+	ISO20022MaxDigits = 18
+	// ISO20022MaxFracDigits is the maximum number of fraction digits
+	// (fractionDigits) of an amount in ISO 20022 messages.
+	ISO20022MaxFracDigits = 5
+	// SWIFTMaxLen is the maximum length of an amount in SWIFT MT messages,
+	// including the decimal comma.
+	SWIFTMaxLen = 15
+)
+
+// checkAmount checks that x is a non-negative amount that fits in
+// the given number of fraction digits; op is the name of the calling
+// function, for errors.
+func checkAmount(op string, x *Dec, frac int) error {
+	if x.Sign() < 0 {
+		return fmt.Errorf("%s: negative amount %v", op, x)
+	}
+	if !x.FitsScale(Scale(frac)) {
+		return fmt.Errorf("%s: amount %v has more than %d fraction digits", op, x, frac)
+	}
+	return nil
+}
+
+// ValidateISO20022 reports whether x is a valid amount in ISO 20022 messages
+// (as ActiveCurrencyAndAmount and similar types) for a currency with the
+// given number of minor units (such as 2 for EUR, 0 for JPY or 3 for BHD).
+// It returns an error if minorUnits is greater than ISO20022MaxFracDigits,
+// if x is negative, or if x formatted with minorUnits fraction digits (see
+// FormatISO20022) would have more than ISO20022MaxDigits digits in total.
+// Fraction digits beyond minorUnits are only allowed if they are zeros.
+func ValidateISO20022(x *Dec, minorUnits int) error {
+	_, err := isoAmount("ValidateISO20022", x, minorUnits)
+	return err
+}
+
+func isoAmount(op string, x *Dec, minorUnits int) (*Dec, error) {
+	if minorUnits < 0 || minorUnits > ISO20022MaxFracDigits {
+		return nil, fmt.Errorf("%s: invalid number of ISO 20022 minor units %d", op, minorUnits)
+	}
+	if err := checkAmount(op, x, minorUnits); err != nil {
+		return nil, err
+	}
+	z := new(Dec).Round(x, Scale(minorUnits), RoundExact)
+	if numDigits(z.UnscaledBig()) > ISO20022MaxDigits {
+		return nil, fmt.Errorf("%s: amount %v has more than %d digits", op, x, ISO20022MaxDigits)
+	}
+	return z, nil
+}
+
+// FormatISO20022 returns the representation of the amount x in ISO 20022
+// messages for a currency with the given number of minor units; that is, x
+// with exactly minorUnits digits after the decimal point '.', and no sign or
+// exponent, such as "1234.50" for 1234.5 EUR or "1000" for 1000 JPY. It
+// returns an error if x is not valid as described for ValidateISO20022.
+func FormatISO20022(x *Dec, minorUnits int) (string, error) {
+	z, err := isoAmount("FormatISO20022", x, minorUnits)
+	if err != nil {
+		return "", err
+	}
+	return z.String(), nil
+}
+
+// FormatSWIFT returns the representation of the amount x in SWIFT MT messages
+// (such as in field 32A) for a currency with the given number of minor units.
+// The decimal separator is a comma, which is mandatory even if x is an
+// integer, and trailing fraction zeros are omitted; for example "1234,5" for
+// 1234.50 EUR and "100," for 100 JPY. It returns an error if x is negative,
+// if it has non-zero fraction digits beyond minorUnits, or if the result
+// would be longer than SWIFTMaxLen characters.
+func FormatSWIFT(x *Dec, minorUnits int) (string, error) {
+	if err := checkAmount("FormatSWIFT", x, minorUnits); err != nil {
+		return "", err
+	}
+	u, s := x.reduced()
+	if s < 0 {
+		u = new(big.Int).Mul(u, exp10(-s))
+		s = 0
+	}
+	ds := u.String()
+	b := make([]byte, 0, SWIFTMaxLen+1)
+	if n := len(ds) - int(s); n > 0 {
+		b = append(append(append(b, ds[:n]...), ','), ds[n:]...)
+	} else {
+		b = append(appendZeros(append(b, "0,"...), int64(-n)), ds...)
+	}
+	if len(b) > SWIFTMaxLen {
+		return "", fmt.Errorf("FormatSWIFT: amount %v is longer than %d characters", x, SWIFTMaxLen)
+	}
+	return string(b), nil
+}
+
+// ParseSWIFT parses an amount in the format of SWIFT MT messages for a
+// currency with the given number of minor units: one or more digits with
+// a mandatory decimal comma, followed by at most minorUnits digits, with at
+// most SWIFTMaxLen characters in total. The scale of the result is
+// minorUnits.
+func ParseSWIFT(s string, minorUnits int) (*Dec, error) {
+	if len(s) > SWIFTMaxLen {
+		return nil, fmt.Errorf("ParseSWIFT: SWIFT amount %q is longer than %d characters", s, SWIFTMaxLen)
+	}
+	comma := -1
+	digits := make([]byte, 0, SWIFTMaxLen)
+	for i := 0; i < len(s); i++ {
+		switch ch := s[i]; {
+		case ch >= '0' && ch <= '9':
+			digits = append(digits, ch)
+		case ch == ',' && comma < 0 && i > 0:
+			comma = i
+		default:
+			return nil, fmt.Errorf("ParseSWIFT: invalid SWIFT amount %q", s)
+		}
+	}
+	if comma < 0 {
+		return nil, fmt.Errorf("ParseSWIFT: invalid SWIFT amount %q (missing decimal comma)", s)
+	}
+	frac := len(s) - comma - 1
+	if frac > minorUnits {
+		return nil, fmt.Errorf("ParseSWIFT: SWIFT amount %q has more than %d fraction digits", s, minorUnits)
+	}
+	for i := frac; i < minorUnits; i++ {
+		digits = append(digits, '0')
+	}
+	return new(Dec).setDigits(false, digits).SetScale(Scale(minorUnits)), nil
+}
@@ -0,0 +1,85 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecFormatISO20022(t *testing.T) {
+	for i, tt := range []struct {
+		x     string
+		minor int
+		out   string // empty if an error is expected
+	}{
+		{"1234.5", 2, "1234.50"},
+		{"1234.500", 2, "1234.50"},
+		{"1000", 0, "1000"},
+		{"0.001", 3, "0.001"},
+		{"0", 2, "0.00"},
+		{"1234.505", 2, ""},
+		{"-1", 2, ""},
+		{"9999999999999999.99", 2, "9999999999999999.99"},
+		{"10000000000000000", 2, ""},
+		{"1", 6, ""},
+	} {
+		x, _ := new(inf.Dec).SetString(tt.x)
+		s, err := inf.FormatISO20022(x, tt.minor)
+		if verr := inf.ValidateISO20022(x, tt.minor); (verr == nil) != (err == nil) {
+			t.Errorf("#%d ValidateISO20022(%v, %d) got %v; FormatISO20022 returned %v",
+				i, x, tt.minor, verr, err)
+		}
+		if tt.out == "" {
+			if err == nil {
+				t.Errorf("#%d FormatISO20022(%v, %d) got %q; expected error", i, x, tt.minor, s)
+			}
+		} else if s != tt.out || err != nil {
+			t.Errorf("#%d FormatISO20022(%v, %d) got %q, %v; expected %q", i, x, tt.minor, s, err, tt.out)
+		}
+	}
+}
+
+func TestDecFormatSWIFT(t *testing.T) {
+	for i, tt := range []struct {
+		x     *inf.Dec
+		minor int
+		out   string // empty if an error is expected
+	}{
+		{inf.NewDec(123450, 2), 2, "1234,5"},
+		{inf.NewDec(123456, 2), 2, "1234,56"},
+		{inf.NewDec(100, 0), 0, "100,"},
+		{inf.NewDec(1, -2), 0, "100,"},
+		{inf.NewDec(0, 2), 2, "0,"},
+		{inf.NewDec(5, 3), 3, "0,005"},
+		{inf.NewDec(5, 3), 2, ""},
+		{inf.NewDec(-1, 0), 2, ""},
+		{inf.NewDec(12345678901234, 0), 2, "12345678901234,"},
+		{inf.NewDec(123456789012345, 0), 2, ""},
+	} {
+		s, err := inf.FormatSWIFT(tt.x, tt.minor)
+		if tt.out == "" {
+			if err == nil {
+				t.Errorf("#%d FormatSWIFT(%v, %d) got %q; expected error", i, tt.x, tt.minor, s)
+			}
+			continue
+		}
+		if s != tt.out || err != nil {
+			t.Errorf("#%d FormatSWIFT(%v, %d) got %q, %v; expected %q", i, tt.x, tt.minor, s, err, tt.out)
+		}
+		if tt.x.Scale() < 0 {
+			continue
+		}
+		x, err := inf.ParseSWIFT(s, tt.minor)
+		if err != nil || x.Cmp(tt.x) != 0 || x.Scale() != inf.Scale(tt.minor) {
+			t.Errorf("#%d ParseSWIFT(%q, %d) got %v, %v; expected %v", i, s, tt.minor, x, err, tt.x)
+		}
+	}
+}
+
+func TestDecParseSWIFTErrors(t *testing.T) {
+	for _, s := range []string{"", "100", ",5", "1,2,3", "1.5", "-1,", "1,234", "1234567890123456,"} {
+		if x, err := inf.ParseSWIFT(s, 2); err == nil {
+			t.Errorf("ParseSWIFT(%q, 2) got %v; expected error", s, x)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package inf
+
+import (
+	"math/big"
+)
+
+// quoRat returns the exact value of x/y as a *big.Rat.
+func quoRat(x, y *Dec) *big.Rat {
+	num := new(big.Int).Set(x.UnscaledBig())
+	den := new(big.Int).Set(y.UnscaledBig())
+	// x/y = (xu/yu) * 10**(ys-xs)
+	shift := checkScale(int64(y.Scale()) - int64(x.Scale()))
+	switch {
+	case shift > 0:
+		num.Mul(num, exp10(shift))
+	case shift < 0:
+		den.Mul(den, exp10(-shift))
+	}
+	return new(big.Rat).SetFrac(num, den)
+}
+
+// QuoPeriodic calculates the quotient x/y exactly, representing it as a
+// (possibly) repeating decimal.
+//
+// When x/y is a repeating decimal, QuoPeriodic returns the non-repeating part
+// as prefix, the digits of the repeating part as repetend, and true for ok.
+// When x/y is a finite decimal, it returns the exact quotient as prefix, ""
+// for repetend and false for ok.
+//
+// The scale of prefix is the smallest non-negative scale at which the
+// repetend starts (or at which the finite quotient can be represented), and
+// repetend is the shortest such repeating digit sequence. For example:
+//
+//	x   y   prefix  repetend  ok
+//	-------------------------------
+//	1   4   0.25    ""        false
+//	1   3   0       "3"       true
+//	1   6   0.1     "6"       true
+//	22  7   3       "142857"  true
+//
+// Note that prefix can not carry the sign of a quotient in the range
+// -1 < x/y < 0 when its non-repeating part is zero (e.g. -1/3); in that case
+// the sign has to be obtained from x and y. QuoPeriodicString handles this.
+//
+// The length of repetend may be up to |y|-1 digits (of the reduced
+// denominator), so QuoPeriodic is only suitable for moderately sized
+// divisors.
+//
+// If y is zero, QuoPeriodic returns nil, "" and false.
+func QuoPeriodic(x, y *Dec) (prefix *Dec, repetend string, ok bool) {
+	if y.Sign() == 0 {
+		return nil, "", false
+	}
+	r := quoRat(x, y)
+	den := r.Denom()
+	// non-repeating digits after the decimal point
+	f2, f5 := factor2(den), factor(den, bigInt[5])
+	k := f2
+	if f5 > k {
+		k = f5
+	}
+	num := new(big.Int).Abs(r.Num())
+	num.Mul(num, exp10(checkScale(int64(k))))
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() < 0 {
+		q.Neg(q)
+	}
+	prefix = NewDecBig(q, checkScale(int64(k)))
+	if rem.Sign() == 0 {
+		return prefix, "", false
+	}
+	// rem/den is a purely periodic fraction; long division until the
+	// remainder repeats
+	start := new(big.Int).Set(rem)
+	digits := make([]byte, 0, 16)
+	d := new(big.Int)
+	for {
+		rem.Mul(rem, bigInt[10])
+		d.QuoRem(rem, den, rem)
+		digits = append(digits, byte('0'+d.Int64()))
+		if rem.Cmp(start) == 0 {
+			break
+		}
+	}
+	return prefix, string(digits), true
+}
+
+// QuoPeriodicString returns the string representation of the quotient x/y,
+// with the repetend (if any) enclosed in parentheses, such as "0.1(6)" for
+// 1/6 or "-0.(3)" for -1/3. Finite quotients are formatted as by String.
+//
+// If y is zero, QuoPeriodicString returns "<nil>", as String does for a nil
+// *Dec. See QuoPeriodic for details.
+func QuoPeriodicString(x, y *Dec) string {
+	prefix, repetend, ok := QuoPeriodic(x, y)
+	if !ok {
+		return prefix.String()
+	}
+	s := make([]byte, 0, 16)
+	if x.Sign()*y.Sign() < 0 {
+		s = append(s, '-')
+	}
+	s = append(s, new(Dec).Abs(prefix).String()...)
+	if prefix.Scale() == 0 {
+		s = append(s, '.')
+	}
+	s = append(s, '(')
+	s = append(s, repetend...)
+	s = append(s, ')')
+	return string(s)
+}
@@ -0,0 +1,43 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decQuoPeriodicTests = []struct {
+	x, y     *inf.Dec
+	prefix   *inf.Dec
+	repetend string
+	ok       bool
+	str      string
+}{
+	{inf.NewDec(0, 0), inf.NewDec(3, 0), inf.NewDec(0, 0), "", false, "0"},
+	{inf.NewDec(1, 0), inf.NewDec(4, 0), inf.NewDec(25, 2), "", false, "0.25"},
+	{inf.NewDec(1, -2), inf.NewDec(4, 0), inf.NewDec(25, 0), "", false, "25"},
+	{inf.NewDec(1, 0), inf.NewDec(3, 0), inf.NewDec(0, 0), "3", true, "0.(3)"},
+	{inf.NewDec(-1, 0), inf.NewDec(3, 0), inf.NewDec(0, 0), "3", true, "-0.(3)"},
+	{inf.NewDec(1, 0), inf.NewDec(6, 0), inf.NewDec(1, 1), "6", true, "0.1(6)"},
+	{inf.NewDec(1, 0), inf.NewDec(-6, 0), inf.NewDec(-1, 1), "6", true, "-0.1(6)"},
+	{inf.NewDec(22, 0), inf.NewDec(7, 0), inf.NewDec(3, 0), "142857", true, "3.(142857)"},
+	{inf.NewDec(1, -3), inf.NewDec(3, 0), inf.NewDec(333, 0), "3", true, "333.(3)"},
+	{inf.NewDec(1, 0), inf.NewDec(12, 1), inf.NewDec(8, 1), "3", true, "0.8(3)"},
+	{inf.NewDec(1, 0), inf.NewDec(11, 0), inf.NewDec(0, 0), "09", true, "0.(09)"},
+	{inf.NewDec(7, 0), inf.NewDec(12, 0), inf.NewDec(58, 2), "3", true, "0.58(3)"},
+}
+
+func TestDecQuoPeriodic(t *testing.T) {
+	for i, tt := range decQuoPeriodicTests {
+		prefix, repetend, ok := inf.QuoPeriodic(tt.x, tt.y)
+		if prefix.Cmp(tt.prefix) != 0 || prefix.Scale() != tt.prefix.Scale() ||
+			repetend != tt.repetend || ok != tt.ok {
+			t.Errorf("#%d QuoPeriodic(%v, %v) got %v, %q, %v; expected %v, %q, %v",
+				i, tt.x, tt.y, prefix, repetend, ok, tt.prefix, tt.repetend, tt.ok)
+		}
+		if s := inf.QuoPeriodicString(tt.x, tt.y); s != tt.str {
+			t.Errorf("#%d QuoPeriodicString(%v, %v) got %q; expected %q",
+				i, tt.x, tt.y, s, tt.str)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package inf
+
+// EvalPoly sets z to the value of the polynomial
+//
+//	coeffs[0] + coeffs[1]*x + coeffs[2]*x**2 + ... + coeffs[n-1]*x**(n-1)
+//
+// rounded using the given Rounder to the specified scale, and returns z.
+// The polynomial is evaluated exactly using Horner's method, and the result
+// is rounded only once. The value of an empty polynomial is 0.
+//
+// If the rounder is RoundExact but the result can not be expressed exactly at
+// the specified scale, EvalPoly returns nil, and the value of z is undefined.
+func (z *Dec) EvalPoly(x *Dec, coeffs []*Dec, s Scale, r Rounder) *Dec {
+	acc := new(Dec)
+	if n := len(coeffs); n > 0 {
+		acc.Set(coeffs[n-1])
+		for i := n - 2; i >= 0; i-- {
+			acc.Mul(acc, x)
+			acc.Add(acc, coeffs[i])
+		}
+	}
+	return z.Round(acc, s, r)
+}
@@ -0,0 +1,46 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decEvalPolyTests = []struct {
+	x      string
+	coeffs []string
+	s      inf.Scale
+	r      inf.Rounder
+	exp    string // empty if nil result expected
+}{
+	{"2", nil, 0, inf.RoundExact, "0"},
+	{"2", []string{"5"}, 1, inf.RoundExact, "5.0"},
+	{"2", []string{"1", "2", "3"}, 0, inf.RoundExact, "17"},
+	{"-0.5", []string{"1", "2", "3"}, 2, inf.RoundExact, "0.75"},
+	{"1.05", []string{"0", "0", "0", "1000"}, 2, inf.RoundHalfEven, "1157.62"},
+	{"1.05", []string{"0", "0", "0", "1000"}, 2, inf.RoundHalfUp, "1157.63"},
+	// exact sum 0.016 rounds to 0.02; rounding each term to 2 digits would
+	// give 0.00
+	{"1", []string{"0.004", "0.004", "0.004", "0.004"}, 2, inf.RoundHalfUp, "0.02"},
+	{"0.1", []string{"0", "0.005"}, 2, inf.RoundExact, ""},
+}
+
+func TestDecEvalPoly(t *testing.T) {
+	for i, tt := range decEvalPolyTests {
+		x, _ := new(inf.Dec).SetString(tt.x)
+		coeffs := make([]*inf.Dec, len(tt.coeffs))
+		for j, c := range tt.coeffs {
+			coeffs[j], _ = new(inf.Dec).SetString(c)
+		}
+		z := new(inf.Dec).EvalPoly(x, coeffs, tt.s, tt.r)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d EvalPoly(%v, %v) got %v; expected nil", i, x, tt.coeffs, z)
+			}
+			continue
+		}
+		if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d EvalPoly(%v, %v) got %v; expected %s", i, x, tt.coeffs, z, tt.exp)
+		}
+	}
+}
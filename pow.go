@@ -0,0 +1,151 @@
+package inf
+
+import (
+	"math/big"
+)
+
+// Pow sets z to x**n and returns z.
+//
+// For n >= 0, the result is exact, with a scale of n * x.Scale(); x**0 is 1
+// (with scale 0) for any x, including zero.
+//
+// For n < 0, the result is 1/x**-n as by QuoExact: it is returned if it is a
+// finite decimal, and otherwise Pow returns nil (as it does if x is zero),
+// and the value of z is undefined. Use PowRound to obtain a rounded result.
+func (z *Dec) Pow(x *Dec, n int64) *Dec {
+	if n < 0 {
+		if x.Sign() == 0 {
+			return nil
+		}
+		return z.QuoExact(NewDec(1, 0), new(Dec).pow(x, uint64(-n)))
+	}
+	return z.pow(x, uint64(n))
+}
+
+// PowRound sets z to x**n, rounded using the given Rounder to the specified
+// scale, and returns z. The power is calculated exactly, and (for n < 0) its
+// reciprocal is rounded only once.
+//
+// PowRound returns nil if n < 0 and x is zero, or if the rounder is
+// RoundExact but the result can not be expressed exactly at the specified
+// scale; the value of z is undefined in that case.
+func (z *Dec) PowRound(x *Dec, n int64, s Scale, r Rounder) *Dec {
+	if n < 0 {
+		if x.Sign() == 0 {
+			return nil
+		}
+		return z.QuoRound(NewDec(1, 0), new(Dec).pow(x, uint64(-n)), s, r)
+	}
+	return z.Round(new(Dec).pow(x, uint64(n)), s, r)
+}
+
+// pow sets z to x**n by exponentiation by squaring, and returns z.
+func (z *Dec) pow(x *Dec, n uint64) *Dec {
+	b := new(Dec).Set(x)
+	z.SetUnscaled(1).SetScale(0)
+	for ; n > 0; n >>= 1 {
+		if n&1 != 0 {
+			z.Mul(z, b)
+		}
+		if n > 1 {
+			b.Mul(b, b)
+		}
+	}
+	return z
+}
+
+// PowDec sets z to x**y, rounded using the given Rounder to the specified
+// scale, and returns z. For exponents that are not integers, the result is
+// calculated as e**(y*ln(x)), and is correctly rounded.
+//
+// PowDec returns nil if x is zero and y is negative, or if x is negative and
+// y is not an integer (or an integer out of the range of int64); it also
+// returns nil if the rounder is RoundExact but the result can not be
+// expressed exactly at the specified scale. The value of z is undefined in
+// that case.
+func (z *Dec) PowDec(x, y *Dec, s Scale, r Rounder) *Dec {
+	switch {
+	case y.Sign() == 0:
+		return z.Round(NewDec(1, 0), s, r)
+	case x.Sign() == 0:
+		if y.Sign() < 0 {
+			return nil
+		}
+		return z.Round(NewDec(0, 0), s, r)
+	}
+	// y = p/q in lowest terms
+	yr := quoRat(y, NewDec(1, 0))
+	p, q := yr.Num(), yr.Denom()
+	if q.Cmp(bigInt[1]) == 0 && p.IsInt64() {
+		return z.PowRound(x, p.Int64(), s, r)
+	}
+	if x.Sign() < 0 {
+		return nil
+	}
+	// x**y is rational only if x is the qth power of a decimal
+	if b := exactRoot(x, q); b != nil && p.IsInt64() {
+		return z.PowRound(b, p.Int64(), s, r)
+	}
+	xm1 := new(Dec).Sub(x, NewDec(1, 0))
+	return z.approx(s, r, 10, func(w Scale) (*big.Int, *big.Int) {
+		one := exp10(w)
+		l, e := ln1pFixed(fixed(xm1, w), w)
+		yw := fixed(y, w)
+		t := new(big.Int).Mul(l, yw)
+		t.Quo(t, one)
+		// error bound of t: (|y|+1)*e + |ln(x)| + 2
+		et := new(big.Int).Abs(yw)
+		et.Quo(et, one)
+		et.Add(et, bigInt[1])
+		et.Mul(et, e)
+		et.Add(et, new(big.Int).Quo(new(big.Int).Abs(l), one))
+		et.Add(et, bigInt[2])
+		m, em := expm1Fixed(t, w)
+		// the error of t is amplified by (at most) e**t, which em bounds
+		return m.Add(m, one), em.Mul(em, et.Add(et, bigInt[1]))
+	})
+}
+
+// exactRoot returns the qth root of x > 0 if it is a decimal, or nil
+// otherwise.
+func exactRoot(x *Dec, q *big.Int) *Dec {
+	if q.Cmp(bigInt[1]) == 0 {
+		return x
+	}
+	xr := quoRat(x, NewDec(1, 0))
+	num, den := xr.Num(), xr.Denom()
+	// the qth root of an integer other than 0 and 1 has at least q bits
+	if !q.IsInt64() || q.Int64() > int64(num.BitLen()) && q.Int64() > int64(den.BitLen()) {
+		if num.Cmp(bigInt[1]) == 0 && den.Cmp(bigInt[1]) == 0 {
+			return x
+		}
+		return nil
+	}
+	n := int(q.Int64())
+	a, b := iroot(num, n), iroot(den, n)
+	if new(big.Int).Exp(a, q, nil).Cmp(num) != 0 || new(big.Int).Exp(b, q, nil).Cmp(den) != 0 {
+		return nil
+	}
+	// den is a product of powers of 2 and 5, and so is b
+	return new(Dec).QuoExact(NewDecBig(a, 0), NewDecBig(b, 0))
+}
+
+// Exp10 returns a new big.Int set to 10**n, or nil if n is negative. Powers
+// up to 10**63 are copied from an internal cache.
+func Exp10(n Scale) *big.Int {
+	if n < 0 {
+		return nil
+	}
+	return new(big.Int).Set(exp10(n))
+}
+
+// NewDecPow10 allocates and returns a new Dec set to 10**n. The scale of the
+// result is 0 for n >= 0, and -n otherwise (so that the unscaled value is 1,
+// as in 0.001 for n = -3). NewDecPow10 panics with ErrScaleOverflow if -n is
+// out of the range of Scale.
+func NewDecPow10(n int) *Dec {
+	if n < 0 {
+		return NewDec(1, checkScale(-int64(n)))
+	}
+	return NewDecBig(exp10(checkScale(int64(n))), 0)
+}
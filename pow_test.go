@@ -0,0 +1,147 @@
+package inf_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecPow(t *testing.T) {
+	for i, tt := range []struct {
+		x   string
+		n   int64
+		exp string // empty if nil is expected
+	}{
+		{"2", 10, "1024"},
+		{"1.5", 2, "2.25"},
+		{"-1.1", 3, "-1.331"},
+		{"1.10", 2, "1.2100"},
+		{"0.0", 3, "0.000"},
+		{"0", 0, "1"},
+		{"12.34", 0, "1"},
+		{"2", -3, "0.125"},
+		{"-0.5", -3, "-8"},
+		{"3", -1, ""},
+		{"0", -1, ""},
+	} {
+		x := decs(tt.x)[0]
+		z := new(inf.Dec).Pow(x, tt.n)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d Pow(%v, %d) got %v; expected nil", i, x, tt.n, z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d Pow(%v, %d) got %v; expected %s", i, x, tt.n, z, tt.exp)
+		}
+	}
+}
+
+func TestDecPowRound(t *testing.T) {
+	for i, tt := range []struct {
+		x   string
+		n   int64
+		s   inf.Scale
+		r   inf.Rounder
+		exp string // empty if nil is expected
+	}{
+		{"1.05", 3, 2, inf.RoundHalfEven, "1.16"},
+		{"1.05", 3, 6, inf.RoundExact, "1.157625"},
+		{"1.05", 3, 2, inf.RoundExact, ""},
+		{"3", -1, 4, inf.RoundHalfEven, "0.3333"},
+		{"3", -2, 4, inf.RoundUp, "0.1112"},
+		{"-3", -3, 4, inf.RoundFloor, "-0.0371"},
+		{"2", -3, 3, inf.RoundExact, "0.125"},
+		{"0", -1, 2, inf.RoundHalfEven, ""},
+	} {
+		x := decs(tt.x)[0]
+		z := new(inf.Dec).PowRound(x, tt.n, tt.s, tt.r)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d PowRound(%v, %d) got %v; expected nil", i, x, tt.n, z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d PowRound(%v, %d) got %v; expected %s", i, x, tt.n, z, tt.exp)
+		}
+	}
+}
+
+func TestDecPowDec(t *testing.T) {
+	for i, tt := range []struct {
+		x, y string
+		s    inf.Scale
+		r    inf.Rounder
+		exp  string // empty if nil is expected
+	}{
+		{"2", "0.5", 10, inf.RoundHalfEven, "1.4142135624"},
+		{"2", "0.5", 10, inf.RoundDown, "1.4142135623"},
+		{"2", "0.5", 10, inf.RoundExact, ""},
+		{"1.05", "2.5", 8, inf.RoundHalfEven, "1.12972632"},
+		{"10", "0.3", 12, inf.RoundHalfEven, "1.995262314969"},
+		{"10", "0.3", 12, inf.RoundDown, "1.995262314968"},
+		{"0.5", "-1.5", 6, inf.RoundHalfEven, "2.828427"},
+		{"1000", "-0.25", 10, inf.RoundHalfEven, "0.1778279410"},
+		{"123.456", "7.89", 4, inf.RoundHalfEven, "31771028258180977.3091"},
+		{"123.456", "7.89", 4, inf.RoundDown, "31771028258180977.3090"},
+		{"1.0001", "10000", 6, inf.RoundHalfEven, "2.718146"},
+		// exact results
+		{"4", "0.5", 2, inf.RoundExact, "2.00"},
+		{"0.25", "-0.5", 0, inf.RoundExact, "2"},
+		{"1.21", "1.5", 3, inf.RoundExact, "1.331"},
+		{"1", "0.123", 1, inf.RoundExact, "1.0"},
+		{"6.25", "0.5", 0, inf.RoundHalfEven, "2"},
+		{"6.25", "0.5", 0, inf.RoundHalfDown, "2"},
+		{"6.25", "0.5", 0, inf.RoundHalfUp, "3"},
+		{"-2", "3", 0, inf.RoundExact, "-8"},
+		{"-2", "-1", 1, inf.RoundExact, "-0.5"},
+		{"5", "0", 1, inf.RoundExact, "1.0"},
+		{"0", "0.5", 1, inf.RoundExact, "0.0"},
+		{"0", "-0.5", 1, inf.RoundExact, ""},
+		{"-2", "0.5", 4, inf.RoundHalfEven, ""},
+	} {
+		xs := decs(tt.x, tt.y)
+		z := new(inf.Dec).PowDec(xs[0], xs[1], tt.s, tt.r)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d PowDec(%v, %v) got %v; expected nil", i, xs[0], xs[1], z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d PowDec(%v, %v) got %v; expected %s", i, xs[0], xs[1], z, tt.exp)
+		}
+	}
+}
+
+func TestExp10(t *testing.T) {
+	for _, n := range []inf.Scale{0, 1, 18, 63, 64, 100} {
+		exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+		e := inf.Exp10(n)
+		if e.Cmp(exp) != 0 {
+			t.Errorf("Exp10(%d) got %v; expected %v", n, e, exp)
+		}
+		// the result must not share the cached value
+		e.SetInt64(7)
+		if e2 := inf.Exp10(n); e2.Cmp(exp) != 0 {
+			t.Errorf("Exp10(%d) got %v after modifying a previous result", n, e2)
+		}
+	}
+	if e := inf.Exp10(-1); e != nil {
+		t.Errorf("Exp10(-1) got %v; expected nil", e)
+	}
+}
+
+func TestNewDecPow10(t *testing.T) {
+	for _, tt := range []struct {
+		n   int
+		exp string
+	}{
+		{0, "1"},
+		{3, "1000"},
+		{-3, "0.001"},
+		{70, "1" + strings.Repeat("0", 70)},
+	} {
+		if z := inf.NewDecPow10(tt.n); z.String() != tt.exp {
+			t.Errorf("NewDecPow10(%d) got %v; expected %s", tt.n, z, tt.exp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package inf
+
+// IsZero reports whether x is zero (with any scale).
+func (x *Dec) IsZero() bool {
+	return x.Sign() == 0
+}
+
+// IsNegative reports whether x < 0.
+func (x *Dec) IsNegative() bool {
+	return x.Sign() < 0
+}
+
+// IsPositive reports whether x > 0.
+func (x *Dec) IsPositive() bool {
+	return x.Sign() > 0
+}
+
+// IsInt reports whether x is an integer; that is, whether its fraction part is
+// zero (as for 12 and 12.00, but not 12.50).
+func (x *Dec) IsInt() bool {
+	if x.Scale() <= 0 {
+		return true
+	}
+	if x.UnscaledBig().Bit(0) != 0 {
+		// odd => not divisible by 10
+		return false
+	}
+	return x.FitsScale(0)
+}
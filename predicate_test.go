@@ -0,0 +1,35 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecPredicates(t *testing.T) {
+	for i, tt := range []struct {
+		x                       *inf.Dec
+		zero, neg, pos, integer bool
+	}{
+		{inf.NewDec(0, 0), true, false, false, true},
+		{inf.NewDec(0, 3), true, false, false, true},
+		{inf.NewDec(1200, 2), false, false, true, true},
+		{inf.NewDec(1250, 2), false, false, true, false},
+		{inf.NewDec(-1201, 2), false, true, false, false},
+		{inf.NewDec(-12, -2), false, true, false, true},
+		{inf.NewDec(-5, 0), false, true, false, true},
+	} {
+		if got := tt.x.IsZero(); got != tt.zero {
+			t.Errorf("#%d %v.IsZero() got %v", i, tt.x, got)
+		}
+		if got := tt.x.IsNegative(); got != tt.neg {
+			t.Errorf("#%d %v.IsNegative() got %v", i, tt.x, got)
+		}
+		if got := tt.x.IsPositive(); got != tt.pos {
+			t.Errorf("#%d %v.IsPositive() got %v", i, tt.x, got)
+		}
+		if got := tt.x.IsInt(); got != tt.integer {
+			t.Errorf("#%d %v.IsInt() got %v", i, tt.x, got)
+		}
+	}
+}
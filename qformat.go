@@ -0,0 +1,46 @@
+package inf
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// ToQ returns the value of x as a signed binary fixed-point number in the
+// Qm.n format; that is, as the m+n bit two's complement integer
+// x * 2**n, where m is the number of integer bits (including the sign bit)
+// and n is the number of fractional bits. For example, Q16.16 values fit in
+// an int32, and Q32.32 values fit in an int64.
+//
+// The fractional part is rounded using the given Rounder. ToQ returns an
+// error if the rounder is RoundExact but x can not be represented exactly
+// with n fractional bits, or if the result does not fit in m+n bits.
+func (x *Dec) ToQ(m, n uint, r Rounder) (*big.Int, error) {
+	if m < 1 {
+		return nil, fmt.Errorf("Dec.ToQ: invalid format Q%d.%d", m, n)
+	}
+	y := NewDecBig(new(big.Int).Lsh(x.UnscaledBig(), n), x.Scale())
+	z := new(Dec).Round(y, 0, r)
+	if z == nil {
+		return nil, fmt.Errorf("Dec.ToQ: %v can not be represented exactly in Q%d.%d", x, m, n)
+	}
+	v := z.UnscaledBig()
+	lim := new(big.Int).Lsh(bigInt[1], m+n-1)
+	if v.Cmp(lim) >= 0 || v.Cmp(lim.Neg(lim)) < 0 {
+		return nil, fmt.Errorf("Dec.ToQ: %v out of range for Q%d.%d", x, m, n)
+	}
+	return new(big.Int).Set(v), nil
+}
+
+// SetQ sets z to the exact value of the binary fixed-point number v with n
+// fractional bits (that is, v * 2**(-n)), and returns z.
+// The scale of z is n; SetQ panics with ErrScaleOverflow if n is greater
+// than MaxScale.
+func (z *Dec) SetQ(v *big.Int, n uint) *Dec {
+	if n > uint(MaxScale) {
+		panic(ErrScaleOverflow)
+	}
+	// v / 2**n == v * 5**n / 10**n
+	f := new(big.Int).Exp(bigInt[5], big.NewInt(int64(n)), nil)
+	z.UnscaledBig().Mul(v, f)
+	return z.SetScale(Scale(n))
+}
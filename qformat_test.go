@@ -0,0 +1,63 @@
+package inf_test
+
+import (
+	"math/big"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decToQTests = []struct {
+	x    string
+	m, n uint
+	r    inf.Rounder
+	q    int64
+	ok   bool
+}{
+	{"0", 16, 16, inf.RoundExact, 0, true},
+	{"1", 16, 16, inf.RoundExact, 1 << 16, true},
+	{"-1", 16, 16, inf.RoundExact, -1 << 16, true},
+	{"0.5", 16, 16, inf.RoundExact, 1 << 15, true},
+	{"-2.75", 16, 16, inf.RoundExact, -(2<<16 + 3<<14), true},
+	{"0.1", 16, 16, inf.RoundExact, 0, false},
+	{"0.1", 16, 16, inf.RoundDown, 6553, true},
+	{"0.1", 16, 16, inf.RoundHalfEven, 6554, true},
+	{"-0.1", 16, 16, inf.RoundFloor, -6554, true},
+	{"32767.99999", 16, 16, inf.RoundDown, 1<<31 - 1, true},
+	{"32768", 16, 16, inf.RoundDown, 0, false},
+	{"-32768", 16, 16, inf.RoundExact, -1 << 31, true},
+	{"-32768.00001", 16, 16, inf.RoundFloor, 0, false},
+	{"1.25", 32, 32, inf.RoundExact, 5 << 30, true},
+	{"1", 0, 16, inf.RoundExact, 0, false},
+}
+
+func TestDecToQ(t *testing.T) {
+	for i, tt := range decToQTests {
+		x, _ := new(inf.Dec).SetString(tt.x)
+		q, err := x.ToQ(tt.m, tt.n, tt.r)
+		if (err == nil) != tt.ok {
+			t.Errorf("#%d ToQ(%d, %d) of %v got error %v; expected ok %v", i, tt.m, tt.n, x, err, tt.ok)
+			continue
+		}
+		if tt.ok && q.Cmp(big.NewInt(tt.q)) != 0 {
+			t.Errorf("#%d ToQ(%d, %d) of %v got %v; expected %v", i, tt.m, tt.n, x, q, tt.q)
+		}
+	}
+}
+
+func TestDecSetQ(t *testing.T) {
+	for i, tt := range decToQTests {
+		if !tt.ok {
+			continue
+		}
+		z := new(inf.Dec).SetQ(big.NewInt(tt.q), tt.n)
+		if z.Scale() != inf.Scale(tt.n) {
+			t.Errorf("#%d SetQ(%d, %d) got scale %d; expected %d", i, tt.q, tt.n, z.Scale(), tt.n)
+		}
+		// SetQ is exact, so converting back must not require rounding
+		q, err := z.ToQ(tt.m, tt.n, inf.RoundExact)
+		if err != nil || q.Cmp(big.NewInt(tt.q)) != 0 {
+			t.Errorf("#%d SetQ(%d, %d) got %v, which converts back to %v, %v", i, tt.q, tt.n, z, q, err)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package inf
+
+// Quantize sets z to the value of x rounded using the given Rounder to the
+// scale of quantum (the exponent of quantum, in the terms of the General
+// Decimal Arithmetic specification), and returns z. inexact reports whether
+// the result differs from x, that is, whether non-zero digits were discarded.
+// The value of quantum is otherwise ignored.
+//
+// If the rounder is RoundExact and the result is inexact, Quantize returns
+// nil (and true), and the value of z is undefined.
+func (z *Dec) Quantize(x, quantum *Dec, r Rounder) (zz *Dec, inexact bool) {
+	q := new(Dec).Round(x, quantum.Scale(), r)
+	if q == nil {
+		return nil, true
+	}
+	inexact = q.Cmp(x) != 0
+	return z.Set(q), inexact
+}
+
+// FloorScale sets z to the value of x rounded towards -infinity to the scale
+// s (the greatest value at that scale not greater than x), and returns z.
+func (z *Dec) FloorScale(x *Dec, s Scale) *Dec {
+	return z.Round(x, s, RoundFloor)
+}
+
+// CeilScale sets z to the value of x rounded towards +infinity to the scale s
+// (the least value at that scale not less than x), and returns z.
+func (z *Dec) CeilScale(x *Dec, s Scale) *Dec {
+	return z.Round(x, s, RoundCeil)
+}
+
+// TruncScale sets z to the value of x rounded towards zero to the scale s,
+// discarding the digits beyond it, and returns z.
+func (z *Dec) TruncScale(x *Dec, s Scale) *Dec {
+	return z.Round(x, s, RoundDown)
+}
+
+// Quantum returns a new Dec set to the quantum of x (in the terms of the
+// General Decimal Arithmetic specification and IEEE 754), 10**-x.Scale(),
+// with the scale of x; it is the same as Ulp. For example, the quantum of
+// 1.50 is 0.01.
+func (x *Dec) Quantum() *Dec {
+	return x.Ulp()
+}
+
+// SameQuantum reports whether x and y have the same quantum; that is, the
+// same scale, regardless of their values.
+func SameQuantum(x, y *Dec) bool {
+	return x.Scale() == y.Scale()
+}
+
+// RoundToStep sets z to the value of x rounded using the given Rounder to an
+// integer multiple of step (such as 0.05 for Swiss cash rounding, or 0.25 for
+// a tick size), and returns z. The sign of step is ignored, so that the
+// directions of RoundFloor and RoundCeil are preserved. The scale of z is the
+// scale of step.
+//
+// RoundToStep returns nil if step is zero, or if the rounder is RoundExact
+// and x is not a multiple of step; the value of z is undefined in that case.
+func (z *Dec) RoundToStep(x, step *Dec, r Rounder) *Dec {
+	if step.Sign() == 0 {
+		return nil
+	}
+	st := new(Dec).Abs(step)
+	n := new(Dec).QuoRound(x, st, 0, r)
+	if n == nil {
+		return nil
+	}
+	return z.Mul(n, st)
+}
@@ -0,0 +1,128 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecQuantize(t *testing.T) {
+	for i, tt := range []struct {
+		x, quantum string
+		r          inf.Rounder
+		exp        string // empty if nil is expected
+		inexact    bool
+	}{
+		{"2.17", "0.001", inf.RoundHalfEven, "2.170", false},
+		{"2.17", "0.01", inf.RoundHalfEven, "2.17", false},
+		{"2.17", "0.1", inf.RoundHalfEven, "2.2", true},
+		{"2.17", "1", inf.RoundHalfEven, "2", true},
+		{"-2.15", "0.1", inf.RoundHalfEven, "-2.2", true},
+		{"-2.15", "0.1", inf.RoundDown, "-2.1", true},
+		{"2.10", "0.1", inf.RoundExact, "2.1", false},
+		{"2.17", "0.1", inf.RoundExact, "", true},
+		{"0.05", "5.0", inf.RoundDown, "0.0", true},
+	} {
+		xs := decs(tt.x, tt.quantum)
+		z, inexact := new(inf.Dec).Quantize(xs[0], xs[1], tt.r)
+		if inexact != tt.inexact {
+			t.Errorf("#%d Quantize(%v, %v) inexact = %v; expected %v", i, xs[0], xs[1], inexact, tt.inexact)
+		}
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d Quantize(%v, %v) got %v; expected nil", i, xs[0], xs[1], z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d Quantize(%v, %v) got %v; expected %s", i, xs[0], xs[1], z, tt.exp)
+		}
+	}
+	// the quantum can have a negative scale
+	if z, inexact := new(inf.Dec).Quantize(inf.NewDec(1250, 0), inf.NewDec(1, -2), inf.RoundHalfUp); z.Cmp(inf.NewDec(13, -2)) != 0 || z.Scale() != -2 || !inexact {
+		t.Errorf("Quantize(1250, 100 at scale -2) got %v, %v; expected 1300 at scale -2, true", z, inexact)
+	}
+}
+
+func TestDecFloorCeilTruncScale(t *testing.T) {
+	for i, tt := range []struct {
+		x                  string
+		s                  inf.Scale
+		floor, ceil, trunc string
+	}{
+		{"1.239", 2, "1.23", "1.24", "1.23"},
+		{"-1.231", 2, "-1.24", "-1.23", "-1.23"},
+		{"1.23", 2, "1.23", "1.23", "1.23"},
+		{"1.2", 3, "1.200", "1.200", "1.200"},
+		{"0.5", 0, "0", "1", "0"},
+		{"-0.5", 0, "-1", "0", "0"},
+		{"-151", -2, "-200", "-100", "-100"},
+	} {
+		x := decs(tt.x)[0]
+		for _, f := range []struct {
+			name string
+			fn   func(z, x *inf.Dec, s inf.Scale) *inf.Dec
+			exp  string
+		}{
+			{"FloorScale", (*inf.Dec).FloorScale, tt.floor},
+			{"CeilScale", (*inf.Dec).CeilScale, tt.ceil},
+			{"TruncScale", (*inf.Dec).TruncScale, tt.trunc},
+		} {
+			if z := f.fn(new(inf.Dec), x, tt.s); z.String() != f.exp || z.Scale() != tt.s {
+				t.Errorf("#%d %s(%v, %d) got %v (scale %d); expected %s", i, f.name, x, tt.s, z, z.Scale(), f.exp)
+			}
+		}
+	}
+}
+
+func TestDecQuantum(t *testing.T) {
+	for i, tt := range []struct {
+		x, y    *inf.Dec
+		quantum string
+		same    bool
+	}{
+		{inf.NewDec(150, 2), inf.NewDec(1, 2), "0.01", true},
+		{inf.NewDec(150, 2), inf.NewDec(15, 1), "0.01", false},
+		{inf.NewDec(0, 3), inf.NewDec(-7, 3), "0.001", true},
+		{inf.NewDec(5, -1), inf.NewDec(50, 0), "10", false},
+	} {
+		if q := tt.x.Quantum(); q.String() != tt.quantum || q.Scale() != tt.x.Scale() {
+			t.Errorf("#%d %v.Quantum() got %v (scale %d); expected %s", i, tt.x, q, q.Scale(), tt.quantum)
+		}
+		if same := inf.SameQuantum(tt.x, tt.y); same != tt.same {
+			t.Errorf("#%d SameQuantum(%v, %v) got %v; expected %v", i, tt.x, tt.y, same, tt.same)
+		}
+	}
+}
+
+func TestDecRoundToStep(t *testing.T) {
+	for i, tt := range []struct {
+		x, step string
+		r       inf.Rounder
+		exp     string // empty if nil is expected
+	}{
+		{"1.02", "0.05", inf.RoundHalfUp, "1.00"},
+		{"1.025", "0.05", inf.RoundHalfUp, "1.05"},
+		{"1.025", "0.05", inf.RoundHalfEven, "1.00"},
+		{"1.075", "0.05", inf.RoundHalfEven, "1.10"},
+		{"1.07", "0.05", inf.RoundHalfUp, "1.05"},
+		{"-1.03", "0.05", inf.RoundHalfUp, "-1.05"},
+		{"-1.03", "0.05", inf.RoundFloor, "-1.05"},
+		{"-1.03", "-0.05", inf.RoundCeil, "-1.00"},
+		{"101.13", "0.25", inf.RoundHalfEven, "101.25"},
+		{"101.12", "0.25", inf.RoundDown, "101.00"},
+		{"12.5", "1", inf.RoundHalfEven, "12"},
+		{"1234", "50", inf.RoundHalfUp, "1250"},
+		{"1.10", "0.05", inf.RoundExact, "1.10"},
+		{"1.11", "0.05", inf.RoundExact, ""},
+		{"1.11", "0", inf.RoundHalfUp, ""},
+	} {
+		xs := decs(tt.x, tt.step)
+		z := new(inf.Dec).RoundToStep(xs[0], xs[1], tt.r)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d RoundToStep(%v, %v) got %v; expected nil", i, xs[0], xs[1], z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d RoundToStep(%v, %v) got %v; expected %s", i, xs[0], xs[1], z, tt.exp)
+		}
+	}
+}
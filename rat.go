@@ -0,0 +1,55 @@
+package inf
+
+import (
+	"math/big"
+)
+
+// BestRat returns the best rational approximation of x with a denominator not
+// greater than maxDen; that is, the closest rational number to x among those
+// with a denominator of at most maxDen. If the exact value of x can be
+// expressed with a denominator of at most maxDen, the result equals x.
+//
+// The approximation is obtained from the continued fraction expansion of x,
+// considering both its convergents and semiconvergents.
+//
+// BestRat returns nil if maxDen is less than 1.
+func BestRat(x *Dec, maxDen *big.Int) *big.Rat {
+	if maxDen.Sign() <= 0 {
+		return nil
+	}
+	r := quoRat(x, NewDec(1, 0))
+	if r.Denom().Cmp(maxDen) <= 0 {
+		return r
+	}
+	// convergents p0/q0 and p1/q1
+	p0, q0 := big.NewInt(0), big.NewInt(1)
+	p1, q1 := big.NewInt(1), big.NewInt(0)
+	n, d := new(big.Int).Set(r.Num()), new(big.Int).Set(r.Denom())
+	a, t := new(big.Int), new(big.Int)
+	for {
+		a.Div(n, d) // floor, as d > 0
+		q2 := new(big.Int).Mul(a, q1)
+		q2.Add(q2, q0)
+		if q2.Cmp(maxDen) > 0 {
+			break
+		}
+		p2 := new(big.Int).Mul(a, p1)
+		p2.Add(p2, p0)
+		p0, q0, p1, q1 = p1, q1, p2, q2
+		t.Mul(a, d)
+		n, d = d, n.Sub(n, t)
+	}
+	// best semiconvergent with a denominator not greater than maxDen
+	k := new(big.Int).Sub(maxDen, q0)
+	k.Div(k, q1)
+	sp := new(big.Int).Mul(k, p1)
+	sq := new(big.Int).Mul(k, q1)
+	b1 := new(big.Rat).SetFrac(sp.Add(sp, p0), sq.Add(sq, q0))
+	b2 := new(big.Rat).SetFrac(p1, q1)
+	d1 := new(big.Rat).Sub(b1, r)
+	d2 := new(big.Rat).Sub(b2, r)
+	if d2.Abs(d2).Cmp(d1.Abs(d1)) <= 0 {
+		return b2
+	}
+	return b1
+}
@@ -0,0 +1,35 @@
+package inf_test
+
+import (
+	"math/big"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decBestRatTests = []struct {
+	x      string
+	maxDen int64
+	r      *big.Rat // nil if no result expected
+}{
+	{"0", 1, big.NewRat(0, 1)},
+	{"0.25", 4, big.NewRat(1, 4)},
+	{"0.25", 3, big.NewRat(1, 3)},
+	{"0.333", 10, big.NewRat(1, 3)},
+	{"-1.5", 1, big.NewRat(-2, 1)},
+	{"1.2345", 100000, big.NewRat(2469, 2000)},
+	{"3.14159265358979", 100, big.NewRat(311, 99)},
+	{"3.14159265358979", 1000, big.NewRat(355, 113)},
+	{"-0.6180339887", 50, big.NewRat(-21, 34)},
+	{"1", 0, nil},
+}
+
+func TestDecBestRat(t *testing.T) {
+	for i, tt := range decBestRatTests {
+		x, _ := new(inf.Dec).SetString(tt.x)
+		r := inf.BestRat(x, big.NewInt(tt.maxDen))
+		if (r == nil) != (tt.r == nil) || r != nil && r.Cmp(tt.r) != 0 {
+			t.Errorf("#%d BestRat(%v, %d) got %v; expected %v", i, x, tt.maxDen, r, tt.r)
+		}
+	}
+}
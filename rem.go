@@ -0,0 +1,62 @@
+package inf
+
+// Rem sets z to the remainder x%y for y != 0 and returns z. The remainder is
+// that of truncated division (with the quotient rounded towards zero), so
+// that it is either zero or has the sign of x, as for big.Int.Rem. The scale
+// of z is the greater of the scales of x and y.
+//
+// Rem panics with ErrDivisionByZero if y is zero.
+func (z *Dec) Rem(x, y *Dec) *Dec {
+	if y.Sign() == 0 {
+		panic(ErrDivisionByZero)
+	}
+	xx, yy := upscale(x, y)
+	s := xx.Scale()
+	z.UnscaledBig().Rem(xx.UnscaledBig(), yy.UnscaledBig())
+	return z.SetScale(s)
+}
+
+// Mod sets z to the modulus x%y for y != 0 and returns z. The modulus is that
+// of Euclidean division, so that it is never negative (0 <= z < |y|), as for
+// big.Int.Mod. The scale of z is the greater of the scales of x and y.
+//
+// Mod panics with ErrDivisionByZero if y is zero.
+func (z *Dec) Mod(x, y *Dec) *Dec {
+	if y.Sign() == 0 {
+		panic(ErrDivisionByZero)
+	}
+	xx, yy := upscale(x, y)
+	s := xx.Scale()
+	z.UnscaledBig().Mod(xx.UnscaledBig(), yy.UnscaledBig())
+	return z.SetScale(s)
+}
+
+// DivMod returns the integer quotient q (with scale 0) and the modulus m of
+// x/y for y != 0, such that q*y + m == x and 0 <= m < |y|; that is, division
+// is Euclidean, as for big.Int.DivMod, and m is the same as by Mod. The scale
+// of m is the greater of the scales of x and y.
+//
+// DivMod panics with ErrDivisionByZero if y is zero.
+func DivMod(x, y *Dec) (q, m *Dec) {
+	if y.Sign() == 0 {
+		panic(ErrDivisionByZero)
+	}
+	xx, yy := upscale(x, y)
+	q, m = new(Dec), new(Dec).SetScale(xx.Scale())
+	q.UnscaledBig().DivMod(xx.UnscaledBig(), yy.UnscaledBig(), m.UnscaledBig())
+	return q, m
+}
+
+// Modf returns the integer part and the fractional part of x, which sum to x
+// and both have the sign of x (or are zero), as for math.Modf; the integer
+// part is x truncated towards zero. The integer part has scale 0 (or the
+// scale of x if it is negative), and the fractional part has the scale of x
+// (or 0 if it is negative).
+func (x *Dec) Modf() (intPart, fracPart *Dec) {
+	if x.Scale() <= 0 {
+		return new(Dec).Set(x), new(Dec)
+	}
+	intPart, fracPart = new(Dec), new(Dec).SetScale(x.Scale())
+	intPart.UnscaledBig().QuoRem(x.UnscaledBig(), exp10(x.Scale()), fracPart.UnscaledBig())
+	return intPart, fracPart
+}
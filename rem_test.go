@@ -0,0 +1,79 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decRemModTests = []struct {
+	x, y          string
+	rem, mod, div string
+}{
+	{"7", "3", "1", "1", "2"},
+	{"-7", "3", "-1", "2", "-3"},
+	{"7", "-3", "1", "1", "-2"},
+	{"-7", "-3", "-1", "2", "3"},
+	{"5.5", "2", "1.5", "1.5", "2"},
+	{"-5.5", "2", "-1.5", "0.5", "-3"},
+	{"10", "0.3", "0.1", "0.1", "33"},
+	{"-10", "0.25", "0.00", "0.00", "-40"},
+	{"0.07", "0.02", "0.01", "0.01", "3"},
+	{"0", "1.5", "0.0", "0.0", "0"},
+}
+
+func TestDecRemMod(t *testing.T) {
+	for i, tt := range decRemModTests {
+		xs := decs(tt.x, tt.y)
+		if z := new(inf.Dec).Rem(xs[0], xs[1]); z.String() != tt.rem {
+			t.Errorf("#%d Rem(%v, %v) got %v; expected %s", i, xs[0], xs[1], z, tt.rem)
+		}
+		if z := new(inf.Dec).Mod(xs[0], xs[1]); z.String() != tt.mod {
+			t.Errorf("#%d Mod(%v, %v) got %v; expected %s", i, xs[0], xs[1], z, tt.mod)
+		}
+		// aliased arguments
+		if z := new(inf.Dec).Set(xs[0]); z.Rem(z, xs[1]).String() != tt.rem {
+			t.Errorf("#%d Rem(z, %v) with z = %s got %v; expected %s", i, xs[1], tt.x, z, tt.rem)
+		}
+		if z := new(inf.Dec).Set(xs[1]); z.Mod(xs[0], z).String() != tt.mod {
+			t.Errorf("#%d Mod(%v, z) with z = %s got %v; expected %s", i, xs[0], tt.y, z, tt.mod)
+		}
+	}
+}
+
+func TestDivMod(t *testing.T) {
+	for i, tt := range decRemModTests {
+		xs := decs(tt.x, tt.y)
+		q, m := inf.DivMod(xs[0], xs[1])
+		if q.String() != tt.div || m.String() != tt.mod {
+			t.Errorf("#%d DivMod(%v, %v) got %v, %v; expected %s, %s", i, xs[0], xs[1], q, m, tt.div, tt.mod)
+		}
+		if x := new(inf.Dec).Mul(q, xs[1]); x.Add(x, m).Cmp(xs[0]) != 0 {
+			t.Errorf("#%d DivMod(%v, %v): q*y + m = %v; expected x", i, xs[0], xs[1], x)
+		}
+	}
+}
+
+func TestDecModf(t *testing.T) {
+	for i, tt := range []struct {
+		x, i, f string
+	}{
+		{"3.75", "3", "0.75"},
+		{"-3.75", "-3", "-0.75"},
+		{"0.05", "0", "0.05"},
+		{"-0.05", "0", "-0.05"},
+		{"12.00", "12", "0.00"},
+		{"42", "42", "0"},
+		{"0", "0", "0"},
+	} {
+		x := decs(tt.x)[0]
+		ip, fp := x.Modf()
+		if ip.String() != tt.i || fp.String() != tt.f {
+			t.Errorf("#%d %v.Modf() got %v, %v; expected %s, %s", i, x, ip, fp, tt.i, tt.f)
+		}
+	}
+	ip, fp := inf.NewDec(15, -2).Modf()
+	if ip.Cmp(inf.NewDec(1500, 0)) != 0 || ip.Scale() != -2 || fp.Sign() != 0 || fp.Scale() != 0 {
+		t.Errorf("1500 (scale -2).Modf() got %v (scale %d), %v (scale %d)", ip, ip.Scale(), fp, fp.Scale())
+	}
+}
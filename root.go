@@ -0,0 +1,120 @@
+package inf
+
+import (
+	"math/big"
+)
+
+// Remainders passed to Rounders when only the position of the discarded
+// fraction relative to one half is known, for fractions that are zero, below
+// one half, exactly one half and above one half, respectively.
+var fracRems = [4][2]*big.Int{
+	{bigInt[0], bigInt[1]},
+	{bigInt[1], bigInt[4]},
+	{bigInt[1], bigInt[2]},
+	{bigInt[3], bigInt[4]},
+}
+
+const (
+	fracZero = iota
+	fracBelowHalf
+	fracHalf
+	fracAboveHalf
+)
+
+// roundFrac sets z to q rounded using r, where q is the result truncated
+// towards zero, neg indicates whether the exact result is negative, and frac
+// describes the discarded fraction (one of fracZero etc). It returns z, or
+// nil if r is RoundExact and frac is not fracZero.
+func (z *Dec) roundFrac(q *Dec, neg bool, frac int, r Rounder) *Dec {
+	rA, rB := new(big.Int).Set(fracRems[frac][0]), new(big.Int).Set(fracRems[frac][1])
+	if neg {
+		rA.Neg(rA)
+	}
+	zz := r.Round(new(Dec), q, rA, rB)
+	if zz == nil {
+		return nil
+	}
+	return z.Set(zz)
+}
+
+// iroot returns the integer nth root of a >= 0, truncated (floor).
+func iroot(a *big.Int, n int) *big.Int {
+	if a.Sign() == 0 {
+		return new(big.Int)
+	}
+	if n == 2 {
+		return new(big.Int).Sqrt(a)
+	}
+	nn, n1 := big.NewInt(int64(n)), big.NewInt(int64(n-1))
+	// Newton's method, starting from above the root
+	x := new(big.Int).Lsh(bigInt[1], uint((a.BitLen()+n-1)/n))
+	t, y := new(big.Int), new(big.Int)
+	for {
+		t.Exp(x, n1, nil)
+		t.Quo(a, t)
+		y.Mul(x, n1)
+		y.Add(y, t)
+		y.Quo(y, nn)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x.Set(y)
+	}
+}
+
+// rootParts returns the nth root of num/den * 10**(s*n) (where num >= 0,
+// den > 0 and n >= 1) truncated to an integer, and the position of the
+// discarded fraction (one of fracZero etc).
+func rootParts(num, den *big.Int, n int, s Scale) (*big.Int, int) {
+	num, den = new(big.Int).Set(num), new(big.Int).Set(den)
+	if e := int64(s) * int64(n); e >= 0 {
+		num.Mul(num, exp10(checkScale(e)))
+	} else {
+		den.Mul(den, exp10(checkScale(-e)))
+	}
+	y := iroot(new(big.Int).Quo(num, den), n)
+	// compare y**n and (y+1/2)**n with num/den
+	t := new(big.Int).Exp(y, big.NewInt(int64(n)), nil)
+	if t.Mul(t, den).Cmp(num) == 0 {
+		return y, fracZero
+	}
+	h := new(big.Int).Lsh(y, 1)
+	h.Add(h, bigInt[1])
+	h.Exp(h, big.NewInt(int64(n)), nil)
+	h.Mul(h, den)
+	t.Lsh(num, uint(n))
+	switch h.Cmp(t) {
+	case -1:
+		return y, fracAboveHalf
+	case 0:
+		return y, fracHalf
+	}
+	return y, fracBelowHalf
+}
+
+// root sets z to the nth root of x, rounded using the given Rounder to the
+// specified scale, and returns z. It returns nil if n < 1, if x is negative
+// and n is even, or if the rounder is RoundExact but the result can not be
+// expressed exactly at the specified scale.
+func (z *Dec) root(x *Dec, n int, s Scale, r Rounder) *Dec {
+	if n < 1 || x.Sign() < 0 && n%2 == 0 {
+		return nil
+	}
+	if n == 1 {
+		return z.Round(x, s, r)
+	}
+	// |x| = num / den
+	num := new(big.Int).Abs(x.UnscaledBig())
+	den := new(big.Int).Set(bigInt[1])
+	if x.Scale() >= 0 {
+		den.Set(exp10(x.Scale()))
+	} else {
+		num.Mul(num, exp10(checkScale(-int64(x.Scale()))))
+	}
+	y, frac := rootParts(num, den, n, s)
+	neg := x.Sign() < 0
+	if neg {
+		y.Neg(y)
+	}
+	return z.roundFrac(NewDecBig(y, s), neg, frac, r)
+}
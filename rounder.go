@@ -2,6 +2,7 @@ package inf
 
 import (
 	"math/big"
+	"math/rand"
 )
 
 // Rounder represents a method for rounding the (possibly infinite decimal)
@@ -47,10 +48,88 @@ type rounder interface {
 	//  -|remDen| < remNum < |remDen|
 	//
 	// remDen has the same sign as y, and remNum is zero or has the same sign
-	// as x.
+	// as x. Rounders implementing RemainderHinter may be passed a simpler
+	// remainder as described by their RemainderHint, which is shared and must
+	// not be modified.
 	Round(z, quo *Dec, remNum, remDen *big.Int) *Dec
 }
 
+// A RemainderHint describes the information about the remainder that a
+// Rounder uses, so that it need not be calculated in full.
+type RemainderHint int
+
+const (
+	// RemainderFull indicates that the remainder is used as is.
+	RemainderFull RemainderHint = iota
+	// RemainderSign indicates that only the signs of remNum and remDen are
+	// used (and whether remNum is zero), as for directed rounding.
+	RemainderSign
+	// RemainderHalf indicates that only the signs are used, along with the
+	// position of the remainder relative to one half, as for rounding to
+	// nearest. The remainder passed is one of 0, 1/4, 1/2 or 3/4 (with the
+	// signs of remNum and remDen), for remainders that are zero, below, at or
+	// above one half, respectively.
+	RemainderHalf
+)
+
+// A RemainderHinter is a Rounder that only needs partial information about
+// the remainder. Rounders that do not implement RemainderHinter are passed
+// the full remainder. The predefined Rounders other than RoundDown (which
+// does not use the remainder) implement RemainderHinter.
+type RemainderHinter interface {
+	Rounder
+	RemainderHint() RemainderHint
+}
+
+// remainderHint returns the RemainderHint of r.
+func remainderHint(r Rounder) RemainderHint {
+	if h, ok := r.(RemainderHinter); ok {
+		return h.RemainderHint()
+	}
+	return RemainderFull
+}
+
+// negFracRems holds the negated values of fracRems.
+var negFracRems = func() (n [4][2]*big.Int) {
+	for i, r := range fracRems {
+		n[i] = [2]*big.Int{new(big.Int).Neg(r[0]), new(big.Int).Neg(r[1])}
+	}
+	return n
+}()
+
+// hintRemainder returns the remainder rem/den in the form described by h,
+// which is not RemainderFull. The results are shared values from fracRems
+// and negFracRems, so that no allocation is needed; they must not be
+// modified.
+func hintRemainder(h RemainderHint, rem, den *big.Int) (*big.Int, *big.Int) {
+	frac := fracZero
+	switch {
+	case rem.Sign() == 0:
+	case h == RemainderSign:
+		frac = fracHalf
+	default:
+		frac = fracHalf + cmpHalf(rem, den)
+	}
+	rA, rB := fracRems[frac][0], fracRems[frac][1]
+	if rem.Sign() < 0 {
+		rA = negFracRems[frac][0]
+	}
+	if den.Sign() < 0 {
+		rB = negFracRems[frac][1]
+	}
+	return rA, rB
+}
+
+// hinted is a Rounder with a RemainderHint.
+type hinted struct {
+	rndr
+	hint RemainderHint
+}
+
+func (r hinted) RemainderHint() RemainderHint {
+	return r.hint
+}
+
 type rndr struct {
 	useRem bool
 	round  func(z, quo *Dec, remNum, remDen *big.Int) *Dec
@@ -66,7 +145,7 @@ func (r rndr) Round(z, quo *Dec, remNum, remDen *big.Int) *Dec {
 
 var intSign = []*big.Int{big.NewInt(-1), big.NewInt(0), big.NewInt(1)}
 
-func roundHalf(f func(c int, odd uint) (roundUp bool)) func(z, q *Dec, rA, rB *big.Int) *Dec {
+func roundHalf(f func(c int, odd uint, sign int) (roundUp bool)) func(z, q *Dec, rA, rB *big.Int) *Dec {
 	return func(z, q *Dec, rA, rB *big.Int) *Dec {
 		z.Set(q)
 		brA, brB := rA.BitLen(), rB.BitLen()
@@ -78,11 +157,7 @@ func roundHalf(f func(c int, odd uint) (roundUp bool)) func(z, q *Dec, rA, rB *b
 		srA, srB := rA.Sign(), rB.Sign()
 		s := srA * srB
 		if brA == brB-1 {
-			rA2 := new(big.Int).Lsh(rA, 1)
-			if s < 0 {
-				rA2.Neg(rA2)
-			}
-			roundUp = f(rA2.Cmp(rB)*srB, z.UnscaledBig().Bit(0))
+			roundUp = f(cmpHalf(rA, rB), z.UnscaledBig().Bit(0), s)
 		} else {
 			// brA > brB-1 => |rA| > |rB/2|
 			roundUp = true
@@ -94,52 +169,179 @@ func roundHalf(f func(c int, odd uint) (roundUp bool)) func(z, q *Dec, rA, rB *b
 	}
 }
 
+// RoundHalfWith returns a Rounder that rounds to nearest, calling tie to decide
+// the direction when the result is at the same distance from both
+// neighboring values. quoIsEven reports whether the last digit of the result
+// rounded towards zero is even, and sign is the sign of the (unrounded)
+// result. tie returns true to round away from zero, and false to round
+// towards zero.
+//
+// For example, RoundHalfEven is equivalent to:
+//
+//	RoundHalfWith(func(quoIsEven bool, sign int) bool { return !quoIsEven })
+//
+func RoundHalfWith(tie func(quoIsEven bool, sign int) bool) Rounder {
+	return hinted{rndr{true, roundHalf(
+		func(c int, odd uint, sign int) bool {
+			return c > 0 || c == 0 && tie(odd == 0, sign)
+		})}, RemainderHalf}
+}
+
+// A RounderFunc is an adapter to allow the use of ordinary functions as
+// Rounders. The function is called with the quotient truncated towards zero
+// and the remainder, as for the Round method of Rounders, and with half, the
+// result of comparing the discarded fraction with one half:
+//
+//	-1 if |remNum/remDen| <  1/2
+//	 0 if |remNum/remDen| == 1/2
+//	+1 if |remNum/remDen| >  1/2
+//
+// The sign of the exact result is that of remNum*remDen if the remainder is
+// not zero. The function sets the rounded value to z and returns z, or nil if
+// the value can not be rounded (as with RoundExact).
+type RounderFunc func(z, quo *Dec, remNum, remDen *big.Int, half int) *Dec
+
+// UseRemainder returns true; the remainder is always passed to f.
+func (f RounderFunc) UseRemainder() bool {
+	return true
+}
+
+// Round calls f(z, quo, remNum, remDen, half).
+func (f RounderFunc) Round(z, quo *Dec, remNum, remDen *big.Int) *Dec {
+	return f(z, quo, remNum, remDen, cmpHalf(remNum, remDen))
+}
+
+// wordBits is the size of a big.Word in bits.
+const wordBits = 32 << (^big.Word(0) >> 63)
+
+// cmpHalf compares |rA/rB| with 1/2; that is, 2*|rA| with |rB|. It does not
+// allocate.
+func cmpHalf(rA, rB *big.Int) int {
+	switch brA, brB := rA.BitLen(), rB.BitLen(); {
+	case brA < brB-1:
+		return -1
+	case brA > brB:
+		return +1
+	}
+	// compare the words of |rA|<<1 with those of |rB|, most significant first
+	a, b := rA.Bits(), rB.Bits()
+	n := len(a) + 1
+	if len(b) > n {
+		n = len(b)
+	}
+	for i := n - 1; i >= 0; i-- {
+		var x, y big.Word
+		if i < len(a) {
+			x = a[i] << 1
+		}
+		if i > 0 && i-1 < len(a) {
+			x |= a[i-1] >> (wordBits - 1)
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return +1
+		}
+	}
+	return 0
+}
+
+// RoundStochastic returns a Rounder that rounds away from zero with a
+// probability equal to the discarded fraction (the absolute value of the
+// remainder), and towards zero otherwise, using random numbers from rng.
+// Exact results are returned unchanged. The expected value of the rounded
+// result is the exact result, so that rounding errors do not accumulate a
+// bias when many rounded results are summed.
+//
+// As rng is not safe for concurrent use, neither is the Rounder.
+func RoundStochastic(rng *rand.Rand) Rounder {
+	return rndr{true,
+		func(z, q *Dec, rA, rB *big.Int) *Dec {
+			z.Set(q)
+			if rA.Sign() == 0 {
+				return z
+			}
+			n := new(big.Int).Abs(rB)
+			if n.Rand(rng, n).CmpAbs(rA) < 0 {
+				z.UnscaledBig().Add(z.UnscaledBig(), intSign[rA.Sign()*rB.Sign()+1])
+			}
+			return z
+		}}
+}
+
+// FirstOf returns a Rounder that rounds using r1, and if r1 returns nil (as
+// RoundExact does for inexact results), using r2 instead. For example,
+// FirstOf(RoundExact, RoundHalfEven) rounds to nearest, but only when needed,
+// which can be detected with FirstOfNotify.
+func FirstOf(r1, r2 Rounder) Rounder {
+	return FirstOfNotify(r1, r2, nil)
+}
+
+// FirstOfNotify is like FirstOf, but calls fallback (if it is not nil)
+// whenever r2 is used.
+func FirstOfNotify(r1, r2 Rounder, fallback func()) Rounder {
+	return rndr{r1.UseRemainder() || r2.UseRemainder(),
+		func(z, q *Dec, rA, rB *big.Int) *Dec {
+			if zz := r1.Round(z, q, rA, rB); zz != nil {
+				return zz
+			}
+			if fallback != nil {
+				fallback()
+			}
+			return r2.Round(z, q, rA, rB)
+		}}
+}
+
 func init() {
-	RoundExact = rndr{true,
+	RoundExact = hinted{rndr{true,
 		func(z, q *Dec, rA, rB *big.Int) *Dec {
 			if rA.Sign() != 0 {
 				return nil
 			}
 			return z.Set(q)
-		}}
+		}}, RemainderSign}
 	RoundDown = rndr{false,
 		func(z, q *Dec, rA, rB *big.Int) *Dec {
 			return z.Set(q)
 		}}
-	RoundUp = rndr{true,
+	RoundUp = hinted{rndr{true,
 		func(z, q *Dec, rA, rB *big.Int) *Dec {
 			z.Set(q)
 			if rA.Sign() != 0 {
 				z.UnscaledBig().Add(z.UnscaledBig(), intSign[rA.Sign()*rB.Sign()+1])
 			}
 			return z
-		}}
-	RoundFloor = rndr{true,
+		}}, RemainderSign}
+	RoundFloor = hinted{rndr{true,
 		func(z, q *Dec, rA, rB *big.Int) *Dec {
 			z.Set(q)
 			if rA.Sign()*rB.Sign() < 0 {
 				z.UnscaledBig().Add(z.UnscaledBig(), intSign[0])
 			}
 			return z
-		}}
-	RoundCeil = rndr{true,
+		}}, RemainderSign}
+	RoundCeil = hinted{rndr{true,
 		func(z, q *Dec, rA, rB *big.Int) *Dec {
 			z.Set(q)
 			if rA.Sign()*rB.Sign() > 0 {
 				z.UnscaledBig().Add(z.UnscaledBig(), intSign[2])
 			}
 			return z
-		}}
-	RoundHalfDown = rndr{true, roundHalf(
-		func(c int, odd uint) bool {
+		}}, RemainderSign}
+	RoundHalfDown = hinted{rndr{true, roundHalf(
+		func(c int, odd uint, sign int) bool {
 			return c > 0
-		})}
-	RoundHalfUp = rndr{true, roundHalf(
-		func(c int, odd uint) bool {
+		})}, RemainderHalf}
+	RoundHalfUp = hinted{rndr{true, roundHalf(
+		func(c int, odd uint, sign int) bool {
 			return c >= 0
-		})}
-	RoundHalfEven = rndr{true, roundHalf(
-		func(c int, odd uint) bool {
+		})}, RemainderHalf}
+	RoundHalfEven = hinted{rndr{true, roundHalf(
+		func(c int, odd uint, sign int) bool {
 			return c > 0 || c == 0 && odd == 1
-		})}
+		})}, RemainderHalf}
 }
@@ -2,6 +2,7 @@ package inf_test
 
 import (
 	"math/big"
+	"math/rand"
 	"testing"
 
 	"gopkg.in/inf.v0"
@@ -107,3 +108,186 @@ func TestDecRounders(t *testing.T) {
 		}
 	}
 }
+
+func TestDecRoundHalfWith(t *testing.T) {
+	var equiv = [...]struct {
+		rounder inf.Rounder
+		tie     func(quoIsEven bool, sign int) bool
+	}{
+		{inf.RoundHalfDown, func(bool, int) bool { return false }},
+		{inf.RoundHalfUp, func(bool, int) bool { return true }},
+		{inf.RoundHalfEven, func(quoIsEven bool, sign int) bool { return !quoIsEven }},
+	}
+	for i, a := range equiv {
+		r := inf.RoundHalfWith(a.tie)
+		for j, input := range decRounderInputs {
+			q := new(inf.Dec).Set(input.quo)
+			rA, rB := new(big.Int).Set(input.rA), new(big.Int).Set(input.rB)
+			res := r.Round(new(inf.Dec), q, rA, rB)
+			exp := a.rounder.Round(new(inf.Dec), q, rA, rB)
+			if res.Cmp(exp) != 0 {
+				t.Errorf("#%d,%d RoundHalfWith got %v; expected %v", i, j, res, exp)
+			}
+		}
+	}
+	// round half towards +infinity
+	r := inf.RoundHalfWith(func(quoIsEven bool, sign int) bool { return sign > 0 })
+	for i, tt := range []struct{ x, exp string }{
+		{"0.15", "0.2"}, {"-0.15", "-0.1"}, {"0.25", "0.3"}, {"-0.25", "-0.2"},
+		{"0.16", "0.2"}, {"-0.16", "-0.2"}, {"0.14", "0.1"}, {"-0.14", "-0.1"},
+	} {
+		x, _ := new(inf.Dec).SetString(tt.x)
+		if z := new(inf.Dec).Round(x, 1, r); z.String() != tt.exp {
+			t.Errorf("#%d Round(%v) got %v; expected %v", i, x, z, tt.exp)
+		}
+	}
+}
+
+func TestDecRoundStochastic(t *testing.T) {
+	r := inf.RoundStochastic(rand.New(rand.NewSource(1)))
+	for _, tt := range []struct {
+		x      *inf.Dec
+		lo, hi *inf.Dec // the two possible results
+	}{
+		{inf.NewDec(3, 1), inf.NewDec(0, 0), inf.NewDec(1, 0)},
+		{inf.NewDec(-3, 1), inf.NewDec(-1, 0), inf.NewDec(0, 0)},
+		{inf.NewDec(125, 2), inf.NewDec(1, 0), inf.NewDec(2, 0)},
+	} {
+		const n = 10000
+		sum := new(inf.Dec)
+		for i := 0; i < n; i++ {
+			z := new(inf.Dec).Round(tt.x, 0, r)
+			if z.Cmp(tt.lo) != 0 && z.Cmp(tt.hi) != 0 {
+				t.Fatalf("Round(%v) got %v; expected %v or %v", tt.x, z, tt.lo, tt.hi)
+			}
+			sum.Add(sum, z)
+		}
+		// the mean must be close to x
+		mean := new(inf.Dec).QuoRound(sum, inf.NewDec(n, 0), 3, inf.RoundHalfEven)
+		if d := new(inf.Dec).Sub(mean, tt.x); d.Abs(d).Cmp(inf.NewDec(2, 2)) > 0 {
+			t.Errorf("mean of stochastically rounded %v got %v", tt.x, mean)
+		}
+	}
+	if z := new(inf.Dec).Round(inf.NewDec(120, 2), 1, r); z.Cmp(inf.NewDec(12, 1)) != 0 {
+		t.Errorf("Round(1.20, 1) got %v; expected 1.2", z)
+	}
+}
+
+func TestRounderFunc(t *testing.T) {
+	// rounds to nearest, with ties towards +infinity
+	halfCeil := inf.RounderFunc(func(z, q *inf.Dec, rA, rB *big.Int, half int) *inf.Dec {
+		z.Set(q)
+		if s := rA.Sign() * rB.Sign(); half > 0 || half == 0 && s > 0 {
+			z.UnscaledBig().Add(z.UnscaledBig(), big.NewInt(int64(s)))
+		}
+		return z
+	})
+	for i, tt := range []struct {
+		x   *inf.Dec
+		exp *inf.Dec
+	}{
+		{inf.NewDec(25, 1), inf.NewDec(3, 0)},
+		{inf.NewDec(-25, 1), inf.NewDec(-2, 0)},
+		{inf.NewDec(-26, 1), inf.NewDec(-3, 0)},
+		{inf.NewDec(24, 1), inf.NewDec(2, 0)},
+		{inf.NewDec(-24, 1), inf.NewDec(-2, 0)},
+		{inf.NewDec(3, 0), inf.NewDec(3, 0)},
+		{inf.NewDec(49999, 4), inf.NewDec(5, 0)},
+		{inf.NewDec(5, 1), inf.NewDec(1, 0)},
+	} {
+		if z := new(inf.Dec).Round(tt.x, 0, halfCeil); z.Cmp(tt.exp) != 0 {
+			t.Errorf("#%d Round(%v) got %v; expected %v", i, tt.x, z, tt.exp)
+		}
+	}
+	// half is computed for remainders of any size and sign
+	for i, tt := range []struct {
+		rA, rB int64
+		half   int
+	}{
+		{0, 1, -1}, {1, 3, -1}, {-1, 3, -1}, {1, -2, 0}, {-2, -4, 0},
+		{2, 3, 1}, {-2, 3, 1}, {999, 2000, -1}, {1001, 2000, 1}, {1000, 2000, 0},
+	} {
+		var got int
+		f := inf.RounderFunc(func(z, q *inf.Dec, rA, rB *big.Int, half int) *inf.Dec {
+			got = half
+			return z.Set(q)
+		})
+		f.Round(new(inf.Dec), new(inf.Dec), big.NewInt(tt.rA), big.NewInt(tt.rB))
+		if got != tt.half {
+			t.Errorf("#%d half for %d/%d got %d; expected %d", i, tt.rA, tt.rB, got, tt.half)
+		}
+	}
+}
+
+func TestFirstOf(t *testing.T) {
+	fallbacks := 0
+	r := inf.FirstOfNotify(inf.RoundExact, inf.RoundHalfEven, func() { fallbacks++ })
+	for i, tt := range []struct {
+		x        *inf.Dec
+		exp      *inf.Dec
+		fallback bool
+	}{
+		{inf.NewDec(120, 2), inf.NewDec(12, 1), false},
+		{inf.NewDec(125, 2), inf.NewDec(12, 1), true},
+		{inf.NewDec(-135, 2), inf.NewDec(-14, 1), true},
+	} {
+		before := fallbacks
+		if z := new(inf.Dec).Round(tt.x, 1, r); z == nil || z.Cmp(tt.exp) != 0 {
+			t.Errorf("#%d Round(%v) got %v; expected %v", i, tt.x, z, tt.exp)
+		}
+		if fb := fallbacks > before; fb != tt.fallback {
+			t.Errorf("#%d Round(%v) fallback %v; expected %v", i, tt.x, fb, tt.fallback)
+		}
+	}
+	if z := new(inf.Dec).QuoRound(inf.NewDec(1, 0), inf.NewDec(3, 0), 2, inf.FirstOf(inf.RoundExact, inf.RoundUp)); z.Cmp(inf.NewDec(34, 2)) != 0 {
+		t.Errorf("QuoRound(1, 3) got %v; expected 0.34", z)
+	}
+	// a fallback that fails too
+	if z := new(inf.Dec).Round(inf.NewDec(125, 2), 1, inf.FirstOf(inf.RoundExact, inf.RoundExact)); z != nil {
+		t.Errorf("Round(1.25) got %v; expected nil", z)
+	}
+}
+
+// hintRecorder records the remainder passed to Round.
+type hintRecorder struct {
+	hint   inf.RemainderHint
+	rA, rB string
+}
+
+func (r *hintRecorder) UseRemainder() bool               { return true }
+func (r *hintRecorder) RemainderHint() inf.RemainderHint { return r.hint }
+
+func (r *hintRecorder) Round(z, q *inf.Dec, rA, rB *big.Int) *inf.Dec {
+	r.rA, r.rB = rA.String(), rB.String()
+	return z.Set(q)
+}
+
+func TestRemainderHint(t *testing.T) {
+	for i, tt := range []struct {
+		x, y   *inf.Dec
+		hint   inf.RemainderHint
+		rA, rB string
+	}{
+		{inf.NewDec(1, 0), inf.NewDec(3, 0), inf.RemainderFull, "1", "3"},
+		{inf.NewDec(1, 0), inf.NewDec(3, 0), inf.RemainderSign, "1", "2"},
+		{inf.NewDec(-1, 0), inf.NewDec(3, 0), inf.RemainderSign, "-1", "2"},
+		{inf.NewDec(6, 0), inf.NewDec(-3, 0), inf.RemainderSign, "0", "-1"},
+		{inf.NewDec(1, 0), inf.NewDec(3, 0), inf.RemainderHalf, "1", "4"},
+		{inf.NewDec(-2, 0), inf.NewDec(3, 0), inf.RemainderHalf, "-3", "4"},
+		{inf.NewDec(3, 0), inf.NewDec(-2, 0), inf.RemainderHalf, "1", "-2"},
+		{inf.NewDec(6, 0), inf.NewDec(3, 0), inf.RemainderHalf, "0", "1"},
+	} {
+		r := &hintRecorder{hint: tt.hint}
+		new(inf.Dec).QuoRound(tt.x, tt.y, 0, r)
+		if r.rA != tt.rA || r.rB != tt.rB {
+			t.Errorf("#%d QuoRound(%v, %v) with hint %d passed %s/%s; expected %s/%s",
+				i, tt.x, tt.y, tt.hint, r.rA, r.rB, tt.rA, tt.rB)
+		}
+	}
+	// Round passes hinted remainders too
+	r := &hintRecorder{hint: inf.RemainderHalf}
+	new(inf.Dec).Round(inf.NewDec(-125, 2), 1, r)
+	if r.rA != "-1" || r.rB != "2" {
+		t.Errorf("Round(-1.25) with RemainderHalf passed %s/%s; expected -1/2", r.rA, r.rB)
+	}
+}
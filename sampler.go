@@ -0,0 +1,67 @@
+package inf
+
+import (
+	"crypto/rand"
+	"errors"
+	"io"
+	"math/big"
+	"sort"
+)
+
+// A WeightedSampler selects indices randomly, with probabilities
+// proportional to a list of decimal weights.
+//
+// The weights are summed exactly at their common scale (see AlignScales), and
+// each selection draws a uniformly distributed integer number of units of
+// that scale below the total, so that the probability of selecting index i
+// is exactly weights[i]/sum(weights).
+type WeightedSampler struct {
+	cum   []big.Int // cumulative sums of the unscaled weights
+	scale Scale
+}
+
+// NewWeightedSampler returns a new WeightedSampler for the given weights. It
+// returns an error if there are no weights, if any of them is negative, or
+// if they are all zero. Indices with zero weight are never selected.
+func NewWeightedSampler(weights []*Dec) (*WeightedSampler, error) {
+	if len(weights) == 0 {
+		return nil, errors.New("NewWeightedSampler: no weights")
+	}
+	ws := &WeightedSampler{
+		cum:   make([]big.Int, len(weights)),
+		scale: AlignScales(weights...),
+	}
+	var sum big.Int
+	for i, w := range weights {
+		if w.Sign() < 0 {
+			return nil, errors.New("NewWeightedSampler: negative weight")
+		}
+		u := UpscaleTo(w, ws.scale, nil).UnscaledBig()
+		ws.cum[i].Set(sum.Add(&sum, u))
+	}
+	if sum.Sign() == 0 {
+		return nil, errors.New("NewWeightedSampler: all weights are zero")
+	}
+	return ws, nil
+}
+
+// Scale returns the scale of the units drawn by s; that is, the greatest
+// scale of the weights.
+func (s *WeightedSampler) Scale() Scale {
+	return s.scale
+}
+
+// Pick selects an index, using random to draw a uniformly distributed number
+// (as by crypto/rand.Int). random may be crypto/rand.Reader, or a seeded
+// *math/rand.Rand for reproducible selections. It returns an error if random
+// fails.
+func (s *WeightedSampler) Pick(random io.Reader) (int, error) {
+	total := &s.cum[len(s.cum)-1]
+	n, err := rand.Int(random, total)
+	if err != nil {
+		return 0, err
+	}
+	return sort.Search(len(s.cum), func(i int) bool {
+		return s.cum[i].Cmp(n) > 0
+	}), nil
+}
@@ -0,0 +1,59 @@
+package inf_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecWeightedSampler(t *testing.T) {
+	s, err := inf.NewWeightedSampler(decs("0.5", "0", "1.50", "2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Scale() != 2 {
+		t.Errorf("Scale got %d; expected 2", s.Scale())
+	}
+	const n = 40000
+	var counts [4]int
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < n; i++ {
+		j, err := s.Pick(rnd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[j]++
+	}
+	for i, exp := range []float64{0.125, 0, 0.375, 0.5} {
+		if got := float64(counts[i]) / n; got < exp-0.01 || got > exp+0.01 {
+			t.Errorf("index %d selected with frequency %v; expected %v", i, got, exp)
+		}
+	}
+	// reproducible with the same seed
+	r1, r2 := rand.New(rand.NewSource(42)), rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		a, _ := s.Pick(r1)
+		b, _ := s.Pick(r2)
+		if a != b {
+			t.Fatalf("#%d Pick with equal seeds got %d and %d", i, a, b)
+		}
+	}
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("no randomness") }
+
+func TestDecWeightedSamplerErrors(t *testing.T) {
+	for i, ws := range [][]*inf.Dec{nil, decs("0", "0.00"), decs("1", "-0.1")} {
+		if s, err := inf.NewWeightedSampler(ws); err == nil {
+			t.Errorf("#%d NewWeightedSampler(%v) got %v; expected error", i, ws, s)
+		}
+	}
+	s, _ := inf.NewWeightedSampler(decs("1", "1"))
+	if _, err := s.Pick(errReader{}); err == nil {
+		t.Errorf("Pick with failing reader succeeded")
+	}
+}
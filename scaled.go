@@ -0,0 +1,38 @@
+package inf
+
+import (
+	"fmt"
+)
+
+// FromScaledInt64 allocates and returns a new Dec set to the value of v
+// minor units with the given scale; e.g. FromScaledInt64(1234, 2) returns
+// 12.34 for an amount of 1234 cents. It is equivalent to NewDec(v, scale).
+func FromScaledInt64(v int64, scale Scale) *Dec {
+	return NewDec(v, scale)
+}
+
+// ScaledInt64 returns the value of x as a number of minor units with the
+// given scale (e.g. cents with scale 2), rounding x to the scale using the
+// given Rounder.
+//
+// ScaledInt64 returns an error if the rounder is RoundExact but x can not be
+// represented exactly at the given scale, or if the result is out of the
+// range of int64.
+func (x *Dec) ScaledInt64(scale Scale, r Rounder) (int64, error) {
+	return x.scaledInt64("ScaledInt64", scale, r)
+}
+
+func (x *Dec) scaledInt64(op string, scale Scale, r Rounder) (int64, error) {
+	z := x
+	if x.Scale() != scale {
+		z = new(Dec).Round(x, scale, r)
+		if z == nil {
+			return 0, fmt.Errorf("Dec.%s: %v can not be represented exactly with scale %d", op, x, scale)
+		}
+	}
+	v, ok := z.Unscaled()
+	if !ok {
+		return 0, fmt.Errorf("Dec.%s: %v out of range", op, x)
+	}
+	return v, nil
+}
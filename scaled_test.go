@@ -0,0 +1,54 @@
+package inf_test
+
+import (
+	"math"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decScaledInt64Tests = []struct {
+	in    string
+	scale inf.Scale
+	r     inf.Rounder
+	v     int64
+	ok    bool
+}{
+	{"12.34", 2, inf.RoundExact, 1234, true},
+	{"12.3", 2, inf.RoundExact, 1230, true},
+	{"-12.34", 2, inf.RoundExact, -1234, true},
+	{"12.345", 2, inf.RoundExact, 0, false},
+	{"12.345", 2, inf.RoundHalfEven, 1234, true},
+	{"12.345", 2, inf.RoundHalfUp, 1235, true},
+	{"-12.345", 2, inf.RoundCeil, -1234, true},
+	{"0.00000001", 8, inf.RoundExact, 1, true},
+	{"21000000", 8, inf.RoundExact, 2100000000000000, true},
+	{"1500", -3, inf.RoundHalfEven, 2, true},
+	{"92233720368547758.07", 2, inf.RoundExact, math.MaxInt64, true},
+	{"92233720368547758.08", 2, inf.RoundExact, 0, false},
+	{"92233720368547758.079", 2, inf.RoundDown, math.MaxInt64, true},
+	{"92233720368547758.079", 2, inf.RoundUp, 0, false},
+}
+
+func TestDecScaledInt64(t *testing.T) {
+	for i, tt := range decScaledInt64Tests {
+		x, _ := new(inf.Dec).SetString(tt.in)
+		v, err := x.ScaledInt64(tt.scale, tt.r)
+		if (err == nil) != tt.ok || tt.ok && v != tt.v {
+			t.Errorf("#%d ScaledInt64(%d) of %v got %v, %v; expected %v, ok %v",
+				i, tt.scale, x, v, err, tt.v, tt.ok)
+			continue
+		}
+		if !tt.ok {
+			continue
+		}
+		// converting back is exact when no rounding occurred
+		z := inf.FromScaledInt64(v, tt.scale)
+		if z.Scale() != tt.scale {
+			t.Errorf("#%d FromScaledInt64(%d, %d) got scale %d", i, v, tt.scale, z.Scale())
+		}
+		if _, err := x.ScaledInt64(tt.scale, inf.RoundExact); err == nil && z.Cmp(x) != 0 {
+			t.Errorf("#%d FromScaledInt64(%d, %d) got %v; expected %v", i, v, tt.scale, z, x)
+		}
+	}
+}
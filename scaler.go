@@ -0,0 +1,146 @@
+package inf
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Preset Scalers, for the policies of common systems.
+var (
+	// ScaleDividend returns the scale of x (the dividend, for quotients).
+	ScaleDividend Scaler = scaleFunc(func(x, y *Dec) Scale { return x.Scale() })
+	// ScaleMaxOperand returns the greater of the scales of x and y, as the
+	// scale of sums (and as for division in some SQL dialects).
+	ScaleMaxOperand Scaler = scaleFunc(func(x, y *Dec) Scale {
+		if x.Scale() > y.Scale() {
+			return x.Scale()
+		}
+		return y.Scale()
+	})
+	// ScaleSumOperands returns the sum of the scales of x and y, as the scale
+	// of products.
+	ScaleSumOperands Scaler = scaleFunc(func(x, y *Dec) Scale {
+		return checkScale(int64(x.Scale()) + int64(y.Scale()))
+	})
+)
+
+type scaleFunc func(x, y *Dec) Scale
+
+func (f scaleFunc) Scale(x, y *Dec) Scale {
+	return f(x, y)
+}
+
+var (
+	scalersMu sync.RWMutex
+	// scalerNames maps the names of Scalers without parameters to the
+	// Scalers, including registered ones (see RegisterScaler).
+	scalerNames = map[string]Scaler{
+		"exact":    ScaleQuoExact,
+		"dividend": ScaleDividend,
+		"max":      ScaleMaxOperand,
+		"sum":      ScaleSumOperands,
+	}
+	// scalerParams maps the names of parameterized Scalers (such as "fixed"
+	// in "fixed:2") to functions returning the Scaler for the parameter.
+	scalerParams = map[string]func(n int) Scaler{
+		"fixed":   func(n int) Scaler { return ScaleFixed(checkScale(int64(n))) },
+		"sigdigs": sigDigits,
+	}
+)
+
+// RegisterScaler makes s available under the given name to ScalerByName.
+// Names are matched case-insensitively. RegisterScaler panics if the name is
+// empty or contains ':', if s is nil, or if the name is already in use
+// (including the names of the predefined Scalers).
+func RegisterScaler(name string, s Scaler) {
+	name = strings.ToLower(name)
+	if name == "" || strings.Contains(name, ":") || s == nil {
+		panic("RegisterScaler: invalid name or nil Scaler")
+	}
+	scalersMu.Lock()
+	defer scalersMu.Unlock()
+	_, dup := scalerNames[name]
+	if _, dupParam := scalerParams[name]; dup || dupParam {
+		panic("RegisterScaler: called twice for " + name)
+	}
+	scalerNames[name] = s
+}
+
+// ScalerByName returns the Scaler with the given name, which is one of:
+//
+//	"exact"      ScaleQuoExact
+//	"dividend"   ScaleDividend
+//	"max"        ScaleMaxOperand
+//	"sum"        ScaleSumOperands
+//	"fixed:N"    ScaleFixed(N), for the scale N (such as "fixed:2")
+//	"sigdigs:N"  ScaleSignificantDigits(N), for N > 0
+//
+// or a name registered with RegisterScaler. Names are matched
+// case-insensitively. The result is false if there is no such Scaler, or if
+// the parameter is not a valid integer (or not positive, for "sigdigs").
+func ScalerByName(name string) (Scaler, bool) {
+	name = strings.ToLower(name)
+	scalersMu.RLock()
+	defer scalersMu.RUnlock()
+	i := strings.IndexByte(name, ':')
+	if i < 0 {
+		s, ok := scalerNames[name]
+		return s, ok
+	}
+	f, ok := scalerParams[name[:i]]
+	if !ok {
+		return nil, false
+	}
+	n, err := strconv.ParseInt(name[i+1:], 10, 32)
+	if err != nil {
+		return nil, false
+	}
+	if s := f(int(n)); s != nil {
+		return s, true
+	}
+	return nil, false
+}
+
+// sigDigits returns ScaleSignificantDigits(n), or nil if n < 1.
+func sigDigits(n int) Scaler {
+	if n < 1 {
+		return nil
+	}
+	return ScaleSignificantDigits(n)
+}
+
+type scaleSigDigits int
+
+func (n scaleSigDigits) Scale(x, y *Dec) Scale {
+	a, b := x.UnscaledBig(), y.UnscaledBig()
+	if a.Sign() == 0 || b.Sign() == 0 {
+		return checkScale(int64(n) - 1)
+	}
+	// e is the exponent of the most significant digit of a/b
+	e := numDigits(a) - numDigits(b)
+	aa, bb := new(big.Int).Abs(a), new(big.Int).Abs(b)
+	if e > 0 {
+		bb.Mul(bb, exp10(checkScale(int64(e))))
+	} else {
+		aa.Mul(aa, exp10(checkScale(-int64(e))))
+	}
+	if aa.Cmp(bb) < 0 {
+		e--
+	}
+	return checkScale(int64(n) - 1 - int64(e) + int64(x.Scale()) - int64(y.Scale()))
+}
+
+// ScaleSignificantDigits returns a Scaler for quotients that returns the
+// scale at which x/y, truncated, has n significant digits, regardless of its
+// magnitude (such as 4 for 1/3 with n = 4, giving 0.3333, and -2 for 12345/1
+// with n = 3, giving 12300 with the unscaled value 123). Rounding away from
+// zero may add a digit (as for 9.999 rounded up to 10.00). A zero quotient
+// gets the scale n-1. ScaleSignificantDigits panics if n < 1.
+func ScaleSignificantDigits(n int) Scaler {
+	if n < 1 {
+		panic("ScaleSignificantDigits: n < 1")
+	}
+	return scaleSigDigits(n)
+}
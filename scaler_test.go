@@ -0,0 +1,124 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+type scaleDividend struct{}
+
+func (scaleDividend) Scale(x, y *inf.Dec) inf.Scale { return x.Scale() }
+
+func TestScalerByName(t *testing.T) {
+	// registered once per process, as with -count
+	if _, ok := inf.ScalerByName("test-dividend"); !ok {
+		inf.RegisterScaler("Test-Dividend", scaleDividend{})
+	}
+	x, y := inf.NewDec(1000, 3), inf.NewDec(8, 0)
+	for _, tt := range []struct {
+		name string
+		exp  inf.Scale
+		ok   bool
+	}{
+		{"exact", 3, true},
+		{"EXACT", 3, true},
+		{"fixed:2", 2, true},
+		{"Fixed:-1", -1, true},
+		{"fixed:0", 0, true},
+		{"test-dividend", 3, true},
+		{"fixed", 0, false},
+		{"fixed:", 0, false},
+		{"fixed:x", 0, false},
+		{"fixed:99999999999", 0, false},
+		{"exact:2", 0, false},
+		{"other", 0, false},
+		{"", 0, false},
+	} {
+		s, ok := inf.ScalerByName(tt.name)
+		if ok != tt.ok {
+			t.Errorf("ScalerByName(%q) got ok %v; expected %v", tt.name, ok, tt.ok)
+			continue
+		}
+		if ok {
+			if got := s.Scale(x, y); got != tt.exp {
+				t.Errorf("ScalerByName(%q).Scale(%v, %v) got %d; expected %d", tt.name, x, y, got, tt.exp)
+			}
+		}
+	}
+	for _, name := range []string{"test-dividend", "exact", "fixed", "a:b", ""} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("RegisterScaler(%q) did not panic", name)
+				}
+			}()
+			inf.RegisterScaler(name, scaleDividend{})
+		}()
+	}
+}
+
+func TestScaleSignificantDigits(t *testing.T) {
+	for i, tt := range []struct {
+		x, y string
+		n    int
+		exp  string
+	}{
+		{"1", "3", 4, "0.3333"},
+		{"2", "3", 4, "0.6667"},
+		{"1000", "3", 4, "333.3"},
+		{"0.001", "3", 4, "0.0003333"},
+		{"-1", "3", 2, "-0.33"},
+		{"1", "-0.03", 3, "-33.3"},
+		{"12345", "1", 3, "12300"},
+		{"10", "1", 1, "10"},
+		{"9.99", "1", 3, "9.99"},
+		{"999", "1000", 2, "1.00"}, // rounding adds a digit
+		{"0", "7", 3, "0.00"},
+		{"1", "5000000000000000000", 3, "0.000000000000000000200"},
+	} {
+		xs := decs(tt.x, tt.y)
+		if z := new(inf.Dec).Quo(xs[0], xs[1], inf.ScaleSignificantDigits(tt.n), inf.RoundHalfUp); z.String() != tt.exp {
+			t.Errorf("#%d Quo(%v, %v) with %d significant digits got %v; expected %s", i, xs[0], xs[1], tt.n, z, tt.exp)
+		}
+	}
+	if s, ok := inf.ScalerByName("sigdigs:3"); !ok || s.Scale(inf.NewDec(1, 0), inf.NewDec(3, 0)) != 3 {
+		t.Errorf(`ScalerByName("sigdigs:3") got %v, %v`, s, ok)
+	}
+	if _, ok := inf.ScalerByName("sigdigs:0"); ok {
+		t.Errorf(`ScalerByName("sigdigs:0") got ok`)
+	}
+}
+
+func TestPresetScalers(t *testing.T) {
+	for i, tt := range []struct {
+		x, y               string
+		dividend, max, sum inf.Scale
+	}{
+		{"1.5", "0.25", 1, 2, 3},
+		{"1.500", "3", 3, 3, 3},
+		{"10", "0.1", 0, 1, 1},
+	} {
+		xs := decs(tt.x, tt.y)
+		for _, s := range []struct {
+			name   string
+			scaler inf.Scaler
+			exp    inf.Scale
+		}{
+			{"dividend", inf.ScaleDividend, tt.dividend},
+			{"max", inf.ScaleMaxOperand, tt.max},
+			{"sum", inf.ScaleSumOperands, tt.sum},
+		} {
+			if got := s.scaler.Scale(xs[0], xs[1]); got != s.exp {
+				t.Errorf("#%d %s Scale(%v, %v) got %d; expected %d", i, s.name, xs[0], xs[1], got, s.exp)
+			}
+			if byName, ok := inf.ScalerByName(s.name); !ok || byName.Scale(xs[0], xs[1]) != s.exp {
+				t.Errorf("#%d ScalerByName(%q) got %v, %v", i, s.name, byName, ok)
+			}
+		}
+	}
+	// 1.50 / 3 = 0.50 at the scale of the dividend
+	if z := new(inf.Dec).Quo(inf.NewDec(150, 2), inf.NewDec(3, 0), inf.ScaleDividend, inf.RoundHalfEven); z.String() != "0.50" {
+		t.Errorf("Quo(1.50, 3) with ScaleDividend got %v; expected 0.50", z)
+	}
+}
@@ -0,0 +1,76 @@
+package inf
+
+import (
+	"fmt"
+)
+
+// A Bracket is an entry in a Schedule: Rate applies to amounts from Threshold
+// up to the Threshold of the next Bracket.
+type Bracket struct {
+	Threshold *Dec
+	Rate      *Dec
+}
+
+// A Schedule is a piecewise rate schedule, such as a progressive tax table or
+// a tiered price list, consisting of brackets with ascending thresholds.
+type Schedule struct {
+	progressive bool
+	brackets    []Bracket
+}
+
+// NewSchedule allocates and returns a new Schedule with the given brackets,
+// which must have strictly ascending thresholds. The brackets are copied.
+//
+// In a progressive (marginal) schedule, each rate applies only to the part of
+// the amount that falls within its bracket, as with income tax. Otherwise,
+// the rate of the bracket that the amount falls into applies to the whole
+// amount, as with flat tiered pricing.
+func NewSchedule(progressive bool, brackets ...Bracket) (*Schedule, error) {
+	sc := &Schedule{progressive: progressive, brackets: make([]Bracket, len(brackets))}
+	for i, b := range brackets {
+		if b.Threshold == nil || b.Rate == nil {
+			return nil, fmt.Errorf("NewSchedule: bracket %d incomplete", i)
+		}
+		if i > 0 && b.Threshold.Cmp(brackets[i-1].Threshold) <= 0 {
+			return nil, fmt.Errorf("NewSchedule: threshold %v of bracket %d not ascending", b.Threshold, i)
+		}
+		sc.brackets[i] = Bracket{new(Dec).Set(b.Threshold), new(Dec).Set(b.Rate)}
+	}
+	return sc, nil
+}
+
+// Apply returns the result of applying the schedule to amount, rounded using
+// the given Rounder to the specified scale. The result is calculated exactly
+// and rounded only once.
+//
+// An amount falls into the last bracket with a threshold less than or equal
+// to it; the result is 0 for amounts below the first threshold. For a
+// progressive schedule, the result is the sum of
+//
+//	rate[i] * (min(amount, threshold[i+1]) - threshold[i])
+//
+// for all brackets with threshold[i] < amount; otherwise it is the rate of
+// the bracket that amount falls into multiplied by amount.
+//
+// If the rounder is RoundExact but the result can not be expressed exactly at
+// the specified scale, Apply returns nil.
+func (sc *Schedule) Apply(amount *Dec, s Scale, r Rounder) *Dec {
+	acc := new(Dec)
+	part := new(Dec)
+	for i, b := range sc.brackets {
+		if amount.Cmp(b.Threshold) < 0 {
+			break
+		}
+		if !sc.progressive {
+			acc.Mul(b.Rate, amount)
+			continue
+		}
+		upper := amount
+		if i+1 < len(sc.brackets) && amount.Cmp(sc.brackets[i+1].Threshold) > 0 {
+			upper = sc.brackets[i+1].Threshold
+		}
+		part.Sub(upper, b.Threshold)
+		acc.Add(acc, part.Mul(part, b.Rate))
+	}
+	return new(Dec).Round(acc, s, r)
+}
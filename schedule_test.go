@@ -0,0 +1,73 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func bracket(threshold, rate string) inf.Bracket {
+	t, _ := new(inf.Dec).SetString(threshold)
+	r, _ := new(inf.Dec).SetString(rate)
+	return inf.Bracket{Threshold: t, Rate: r}
+}
+
+var decScheduleBrackets = []inf.Bracket{
+	bracket("0", "0"),
+	bracket("10000", "0.10"),
+	bracket("40000", "0.25"),
+	bracket("100000", "0.40"),
+}
+
+var decScheduleTests = []struct {
+	amount      string
+	progressive string
+	flat        string
+}{
+	{"-5", "0.00", "0.00"},
+	{"0", "0.00", "0.00"},
+	{"9999.99", "0.00", "0.00"},
+	{"10000", "0.00", "1000.00"},
+	{"10000.01", "0.00", "1000.00"},
+	{"10000.05", "0.01", "1000.01"},
+	{"25000", "1500.00", "2500.00"},
+	{"40000", "3000.00", "10000.00"},
+	{"55555.55", "6888.89", "13888.89"},
+	{"100000", "18000.00", "40000.00"},
+	{"250000", "78000.00", "100000.00"},
+}
+
+func TestDecSchedule(t *testing.T) {
+	prog, err := inf.NewSchedule(true, decScheduleBrackets...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	flat, err := inf.NewSchedule(false, decScheduleBrackets...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, tt := range decScheduleTests {
+		amount, _ := new(inf.Dec).SetString(tt.amount)
+		if z := prog.Apply(amount, 2, inf.RoundHalfUp); z.String() != tt.progressive {
+			t.Errorf("#%d progressive Apply(%v) got %v; expected %s", i, amount, z, tt.progressive)
+		}
+		if z := flat.Apply(amount, 2, inf.RoundHalfUp); z.String() != tt.flat {
+			t.Errorf("#%d flat Apply(%v) got %v; expected %s", i, amount, z, tt.flat)
+		}
+	}
+	if z := prog.Apply(inf.NewDec(1000001, 2), 2, inf.RoundExact); z != nil {
+		t.Errorf("Apply with RoundExact got %v; expected nil", z)
+	}
+}
+
+func TestDecNewScheduleInvalid(t *testing.T) {
+	for i, bs := range [][]inf.Bracket{
+		{bracket("10", "0.1"), bracket("10.00", "0.2")},
+		{bracket("10", "0.1"), bracket("5", "0.2")},
+		{{Threshold: inf.NewDec(0, 0)}},
+	} {
+		if _, err := inf.NewSchedule(true, bs...); err == nil {
+			t.Errorf("#%d NewSchedule got no error", i)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package inf
+
+// CopySign sets z to the absolute value of x with the sign of y, and returns
+// z. The scale of z is the scale of x. As Dec has no negative zero, z is
+// non-negative if y is zero, and zero if x is zero.
+func (z *Dec) CopySign(x, y *Dec) *Dec {
+	neg := y.Sign() < 0
+	z.Abs(x)
+	if neg {
+		z.UnscaledBig().Neg(z.UnscaledBig())
+	}
+	return z
+}
+
+// SetSign sets the sign of z, keeping its absolute value and scale if s is
+// not zero, and returns z: z is set to |z| if s > 0, to -|z| if s < 0, and to
+// zero if s == 0.
+func (z *Dec) SetSign(s int) *Dec {
+	u := z.UnscaledBig()
+	switch {
+	case s > 0:
+		u.Abs(u)
+	case s < 0:
+		u.Abs(u)
+		u.Neg(u)
+	default:
+		u.SetInt64(0)
+	}
+	return z
+}
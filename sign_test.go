@@ -0,0 +1,48 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecCopySign(t *testing.T) {
+	for i, tt := range []struct {
+		x, y, exp string
+	}{
+		{"1.50", "-2", "-1.50"},
+		{"-1.50", "2", "1.50"},
+		{"-1.50", "-0.1", "-1.50"},
+		{"-1.50", "0", "1.50"},
+		{"0.0", "-1", "0.0"},
+	} {
+		xs := decs(tt.x, tt.y)
+		if z := new(inf.Dec).CopySign(xs[0], xs[1]); z.String() != tt.exp {
+			t.Errorf("#%d CopySign(%v, %v) got %v; expected %s", i, xs[0], xs[1], z, tt.exp)
+		}
+		// aliased
+		if z := new(inf.Dec).Set(xs[1]); z.CopySign(xs[0], z).String() != tt.exp {
+			t.Errorf("#%d CopySign(%v, z) with z = %v got %v; expected %s", i, xs[0], xs[1], z, tt.exp)
+		}
+	}
+}
+
+func TestDecSetSign(t *testing.T) {
+	for i, tt := range []struct {
+		x   string
+		s   int
+		exp string
+	}{
+		{"1.50", -1, "-1.50"},
+		{"-1.50", -5, "-1.50"},
+		{"-1.50", 1, "1.50"},
+		{"1.50", 3, "1.50"},
+		{"-1.50", 0, "0.00"},
+		{"0", -1, "0"},
+	} {
+		z := decs(tt.x)[0]
+		if z.SetSign(tt.s); z.String() != tt.exp {
+			t.Errorf("#%d %s.SetSign(%d) got %v; expected %s", i, tt.x, tt.s, z, tt.exp)
+		}
+	}
+}
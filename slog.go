@@ -0,0 +1,31 @@
+//go:build go1.21
+// +build go1.21
+
+package inf
+
+import (
+	"log/slog"
+)
+
+// LogValue implements the slog.LogValuer interface. It returns the exact
+// string representation of x, as String.
+func (x *Dec) LogValue() slog.Value {
+	return slog.StringValue(x.String())
+}
+
+// LogDetail returns a slog group value with the string representation of x
+// as "value", along with its unscaled value and scale as "unscaled" and
+// "scale". It is intended for debug logging, where the representation of a
+// Dec (not only its mathematical value) is of interest.
+func (x *Dec) LogDetail() slog.Value {
+	if x == nil {
+		return x.LogValue()
+	}
+	return slog.GroupValue(
+		slog.String("value", x.String()),
+		slog.String("unscaled", x.UnscaledBig().String()),
+		slog.Int64("scale", int64(x.Scale())),
+	)
+}
+
+var _ slog.LogValuer = new(Dec)
@@ -0,0 +1,33 @@
+//go:build go1.21
+// +build go1.21
+
+package inf_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	opts := &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}
+	l := slog.New(slog.NewTextHandler(&buf, opts))
+	var nilDec *inf.Dec
+	l.Info("msg", "amount", inf.NewDec(-12345, 3), "nil", nilDec)
+	l.Info("msg", "amount", inf.NewDec(1200, 2).LogDetail())
+	exp := "level=INFO msg=msg amount=-12.345 nil=<nil>\n" +
+		"level=INFO msg=msg amount.value=12.00 amount.unscaled=1200 amount.scale=2\n"
+	if s := buf.String(); s != exp {
+		t.Errorf("got\n%s\nexpected\n%s", s, exp)
+	}
+}
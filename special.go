@@ -0,0 +1,324 @@
+package inf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Form describes whether an ExtDec is a finite value or a special value.
+type Form int8
+
+const (
+	Finite   Form = iota // finite value (a Dec)
+	Infinite             // +Infinity or -Infinity
+	NaN                  // not a number
+)
+
+// An ExtDec is a Dec extended with the special values NaN, +Infinity and
+// -Infinity, as in IEEE 754 decimal arithmetic, for interchange with formats
+// that represent them (such as IEEE decimal formats and SQL floats). Dec
+// itself only represents finite values.
+//
+// The operations on ExtDec propagate special values as defined by IEEE 754
+// and the General Decimal Arithmetic specification: an operation with a NaN
+// operand returns NaN, as do operations without a defined result, such as
+// Inf-Inf, 0*Inf, Inf/Inf and 0/0. The division of a non-zero value by zero
+// returns an infinity.
+//
+// Unlike Dec, ExtDec also distinguishes negative zero, which is preserved by
+// Neg, SetString (for inputs such as "-0.00") and String, and results from
+// operations as in IEEE 754; for example, the product of -1 and 0 is -0.
+// Negative and positive zeros are equal as compared by Cmp.
+//
+// The zero value for an ExtDec represents the finite value +0 with scale 0.
+type ExtDec struct {
+	form Form
+	neg  bool // sign of an infinity or a zero
+	dec  Dec  // finite value
+}
+
+// NewExtDec allocates and returns a new ExtDec set to the value of x.
+func NewExtDec(x *Dec) *ExtDec {
+	return new(ExtDec).SetDec(x)
+}
+
+// Form returns the form of x.
+func (x *ExtDec) Form() Form {
+	return x.form
+}
+
+// IsNaN reports whether x is NaN.
+func (x *ExtDec) IsNaN() bool {
+	return x.form == NaN
+}
+
+// IsInf reports whether x is +Infinity or -Infinity.
+func (x *ExtDec) IsInf() bool {
+	return x.form == Infinite
+}
+
+// Sign returns:
+//
+//	-1 if x <  0 (including -Infinity)
+//	 0 if x == 0 or x is NaN
+//	+1 if x >  0 (including +Infinity)
+func (x *ExtDec) Sign() int {
+	switch {
+	case x.form == Finite:
+		return x.dec.Sign()
+	case x.form == NaN:
+		return 0
+	case x.neg:
+		return -1
+	}
+	return 1
+}
+
+// Signbit reports whether x is negative or negative zero.
+func (x *ExtDec) Signbit() bool {
+	if x.form == Finite && x.dec.Sign() != 0 {
+		return x.dec.Sign() < 0
+	}
+	return x.neg
+}
+
+// Dec returns a new Dec set to the value of x, or nil if x is not finite.
+// The sign of a negative zero is lost, as Dec does not distinguish it.
+func (x *ExtDec) Dec() *Dec {
+	if x.form != Finite {
+		return nil
+	}
+	return new(Dec).Set(&x.dec)
+}
+
+// Set sets z to the value of x and returns z.
+func (z *ExtDec) Set(x *ExtDec) *ExtDec {
+	if z != x {
+		z.form, z.neg = x.form, x.neg
+		z.dec.Set(&x.dec)
+	}
+	return z
+}
+
+// SetDec sets z to the finite value x and returns z. A zero x sets z to +0.
+func (z *ExtDec) SetDec(x *Dec) *ExtDec {
+	z.form, z.neg = Finite, false
+	z.dec.Set(x)
+	return z
+}
+
+// setFinite sets z to the finite value z.dec, which is negative zero if it is
+// zero and neg is set, and returns z.
+func (z *ExtDec) setFinite(neg bool) *ExtDec {
+	z.form, z.neg = Finite, neg && z.dec.Sign() == 0
+	return z
+}
+
+// SetInf sets z to -Infinity if signbit is set, or +Infinity otherwise, and
+// returns z.
+func (z *ExtDec) SetInf(signbit bool) *ExtDec {
+	z.form, z.neg = Infinite, signbit
+	z.dec.SetUnscaled(0).SetScale(0)
+	return z
+}
+
+// SetNaN sets z to NaN and returns z.
+func (z *ExtDec) SetNaN() *ExtDec {
+	z.form, z.neg = NaN, false
+	z.dec.SetUnscaled(0).SetScale(0)
+	return z
+}
+
+// Neg sets z to -x and returns z.
+func (z *ExtDec) Neg(x *ExtDec) *ExtDec {
+	z.Set(x)
+	switch {
+	case z.form == Finite && z.dec.Sign() != 0:
+		z.dec.Neg(&z.dec)
+	case z.form != NaN:
+		z.neg = !z.neg
+	}
+	return z
+}
+
+// Abs sets z to |x| and returns z.
+func (z *ExtDec) Abs(x *ExtDec) *ExtDec {
+	z.Set(x)
+	z.neg = false
+	if z.form == Finite {
+		z.dec.Abs(&z.dec)
+	}
+	return z
+}
+
+// Add sets z to the sum x+y and returns z.
+// The sum of infinities with opposite signs is NaN. A zero sum is -0 only if
+// both x and y are -0.
+func (z *ExtDec) Add(x, y *ExtDec) *ExtDec {
+	switch {
+	case x.form == NaN || y.form == NaN:
+		return z.SetNaN()
+	case x.form == Infinite && y.form == Infinite:
+		if x.neg != y.neg {
+			return z.SetNaN()
+		}
+		return z.SetInf(x.neg)
+	case x.form == Infinite:
+		return z.SetInf(x.neg)
+	case y.form == Infinite:
+		return z.SetInf(y.neg)
+	}
+	neg := x.Signbit() && y.Signbit()
+	z.dec.Add(&x.dec, &y.dec)
+	return z.setFinite(neg)
+}
+
+// Sub sets z to the difference x-y and returns z.
+// The difference of infinities with the same sign is NaN.
+func (z *ExtDec) Sub(x, y *ExtDec) *ExtDec {
+	return z.Add(x, new(ExtDec).Neg(y))
+}
+
+// Mul sets z to the product x*y and returns z.
+// The product of zero and an infinity is NaN.
+func (z *ExtDec) Mul(x, y *ExtDec) *ExtDec {
+	neg := x.Signbit() != y.Signbit()
+	switch {
+	case x.form == NaN || y.form == NaN:
+		return z.SetNaN()
+	case x.form == Infinite || y.form == Infinite:
+		if x.Sign() == 0 || y.Sign() == 0 {
+			return z.SetNaN()
+		}
+		return z.SetInf(neg)
+	}
+	z.dec.Mul(&x.dec, &y.dec)
+	return z.setFinite(neg)
+}
+
+// Quo sets z to the quotient x/y and returns z. Finite quotients are
+// calculated as by Dec.Quo with the given Scaler and Rounder; if the Rounder
+// returns nil, Quo returns nil and the value of z is undefined.
+//
+// The quotient of a finite value and an infinity is zero with the scale of x.
+// The quotient of a non-zero value and zero is an infinity. The quotients 0/0
+// and Inf/Inf are NaN. Infinite and zero results have the sign of the exact
+// quotient, such as -Infinity for 1/-0, and -0.00 for -0.001/1 rounded down to
+// the scale 2.
+func (z *ExtDec) Quo(x, y *ExtDec, s Scaler, r Rounder) *ExtDec {
+	neg := x.Signbit() != y.Signbit()
+	switch {
+	case x.form == NaN || y.form == NaN:
+		return z.SetNaN()
+	case x.form == Infinite && y.form == Infinite:
+		return z.SetNaN()
+	case x.form == Infinite:
+		return z.SetInf(neg)
+	case y.form == Infinite:
+		z.dec.SetUnscaled(0).SetScale(x.dec.Scale())
+		return z.setFinite(neg)
+	case y.Sign() == 0:
+		if x.Sign() == 0 {
+			return z.SetNaN()
+		}
+		return z.SetInf(neg)
+	}
+	q := new(Dec).Quo(&x.dec, &y.dec, s, r)
+	if q == nil {
+		return nil
+	}
+	z.dec.Set(q)
+	return z.setFinite(neg)
+}
+
+// rank returns the position of the form and sign of x in the order used by
+// Cmp.
+func (x *ExtDec) rank() int {
+	switch {
+	case x.form == NaN:
+		return 0
+	case x.form == Finite:
+		return 2
+	case x.neg:
+		return 1
+	}
+	return 3
+}
+
+// Cmp compares x and y and returns:
+//
+//	-1 if x <  y
+//	 0 if x == y
+//	+1 if x >  y
+//
+// -Infinity is less and +Infinity is greater than all finite values, and -0
+// equals +0. Unlike in IEEE 754, NaN is not unordered: NaNs are equal to each
+// other and less than all other values, so that Cmp is a total order, as
+// needed for sorting. Use IsNaN to detect NaNs.
+func (x *ExtDec) Cmp(y *ExtDec) int {
+	rx, ry := x.rank(), y.rank()
+	switch {
+	case rx < ry:
+		return -1
+	case rx > ry:
+		return 1
+	case rx == 2:
+		return x.dec.Cmp(&y.dec)
+	}
+	return 0
+}
+
+// String returns the string representation of x: "NaN", "Infinity" or
+// "-Infinity" for special values, and that of Dec for finite values, with a
+// "-" sign for negative zero (such as "-0.00").
+func (x *ExtDec) String() string {
+	switch {
+	case x == nil:
+		return "<nil>"
+	case x.form == Finite && x.neg:
+		return "-" + x.dec.String()
+	case x.form == Finite:
+		return x.dec.String()
+	case x.form == NaN:
+		return "NaN"
+	case x.neg:
+		return "-Infinity"
+	}
+	return "Infinity"
+}
+
+// SetString sets z to the value of s and returns z and a boolean indicating
+// success. In addition to the representations accepted by Dec.SetString, s
+// can be "NaN", "Inf" or "Infinity" (ignoring case), optionally preceded by a
+// sign. If SetString fails, the value of z is undefined but the returned
+// value is nil.
+func (z *ExtDec) SetString(s string) (*ExtDec, bool) {
+	neg, t := false, s
+	if len(t) > 0 && (t[0] == '+' || t[0] == '-') {
+		neg, t = t[0] == '-', t[1:]
+	}
+	switch strings.ToLower(t) {
+	case "inf", "infinity":
+		return z.SetInf(neg), true
+	case "nan":
+		return z.SetNaN(), true
+	}
+	if _, ok := z.dec.SetString(s); !ok {
+		return nil, false
+	}
+	return z.setFinite(neg), true
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (x *ExtDec) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (z *ExtDec) UnmarshalText(data []byte) error {
+	_, ok := z.SetString(string(data))
+	if !ok {
+		return fmt.Errorf("invalid inf.ExtDec")
+	}
+	return nil
+}
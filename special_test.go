@@ -0,0 +1,170 @@
+package inf_test
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func extDec(s string) *inf.ExtDec {
+	x, ok := new(inf.ExtDec).SetString(s)
+	if !ok {
+		panic("invalid ExtDec " + s)
+	}
+	return x
+}
+
+var extDecArithTests = []struct {
+	x, y               string
+	add, sub, mul, quo string
+}{
+	{"1.5", "0.5", "2.0", "1.0", "0.75", "3.00"},
+	{"Infinity", "1", "Infinity", "Infinity", "Infinity", "Infinity"},
+	{"-Infinity", "-2", "-Infinity", "-Infinity", "Infinity", "Infinity"},
+	{"1.50", "-Infinity", "-Infinity", "Infinity", "-Infinity", "-0.00"},
+	{"Infinity", "Infinity", "Infinity", "NaN", "Infinity", "NaN"},
+	{"Infinity", "-Infinity", "NaN", "Infinity", "-Infinity", "NaN"},
+	{"0", "Infinity", "Infinity", "-Infinity", "NaN", "0"},
+	{"Infinity", "0", "Infinity", "Infinity", "NaN", "Infinity"},
+	{"-3", "0", "-3", "-3", "-0", "-Infinity"},
+	{"0", "0", "0", "0", "0", "NaN"},
+	{"NaN", "1", "NaN", "NaN", "NaN", "NaN"},
+	{"1", "NaN", "NaN", "NaN", "NaN", "NaN"},
+}
+
+func TestExtDecArith(t *testing.T) {
+	for i, tt := range extDecArithTests {
+		x, y := extDec(tt.x), extDec(tt.y)
+		for _, op := range []struct {
+			name string
+			got  *inf.ExtDec
+			exp  string
+		}{
+			{"Add", new(inf.ExtDec).Add(x, y), tt.add},
+			{"Sub", new(inf.ExtDec).Sub(x, y), tt.sub},
+			{"Mul", new(inf.ExtDec).Mul(x, y), tt.mul},
+			{"Quo", new(inf.ExtDec).Quo(x, y, inf.ScaleFixed(2), inf.RoundHalfEven), tt.quo},
+		} {
+			if op.got.String() != op.exp {
+				t.Errorf("#%d %s(%v, %v) got %v; expected %s", i, op.name, x, y, op.got, op.exp)
+			}
+		}
+	}
+	if z := new(inf.ExtDec).Quo(extDec("1"), extDec("3"), inf.ScaleFixed(2), inf.RoundExact); z != nil {
+		t.Errorf("Quo(1, 3) with RoundExact got %v; expected <nil>", z)
+	}
+}
+
+var extDecSignedZeroTests = []struct {
+	x, y               string
+	add, sub, mul, quo string
+}{
+	{"-0", "-0", "-0", "0", "0", "NaN"},
+	{"-0", "0", "0", "-0", "-0", "NaN"},
+	{"0", "-0", "0", "0", "-0", "NaN"},
+	{"-0.00", "1", "1.00", "-1.00", "-0.00", "-0.00"},
+	{"1", "-0", "1", "1", "-0", "-Infinity"},
+	{"-1", "1", "0", "-2", "-1", "-1.00"},
+	{"-0.001", "1", "0.999", "-1.001", "-0.001", "-0.00"},
+	{"-Infinity", "-0", "-Infinity", "-Infinity", "NaN", "Infinity"},
+}
+
+func TestExtDecSignedZero(t *testing.T) {
+	for i, tt := range extDecSignedZeroTests {
+		x, y := extDec(tt.x), extDec(tt.y)
+		for _, op := range []struct {
+			name string
+			got  *inf.ExtDec
+			exp  string
+		}{
+			{"Add", new(inf.ExtDec).Add(x, y), tt.add},
+			{"Sub", new(inf.ExtDec).Sub(x, y), tt.sub},
+			{"Mul", new(inf.ExtDec).Mul(x, y), tt.mul},
+			{"Quo", new(inf.ExtDec).Quo(x, y, inf.ScaleFixed(2), inf.RoundDown), tt.quo},
+		} {
+			if op.got.String() != op.exp {
+				t.Errorf("#%d %s(%v, %v) got %v; expected %s", i, op.name, x, y, op.got, op.exp)
+			}
+		}
+	}
+	nz := extDec("-0.00")
+	if !nz.Signbit() || nz.Sign() != 0 || nz.Cmp(extDec("0")) != 0 {
+		t.Errorf("-0.00 got Signbit %v, Sign %d, Cmp(0) %d; expected true, 0, 0",
+			nz.Signbit(), nz.Sign(), nz.Cmp(extDec("0")))
+	}
+	if z := new(inf.ExtDec).Neg(nz); z.String() != "0.00" || z.Signbit() {
+		t.Errorf("Neg(-0.00) got %v; expected 0.00", z)
+	}
+	if z := new(inf.ExtDec).Neg(extDec("0")); z.String() != "-0" {
+		t.Errorf("Neg(0) got %v; expected -0", z)
+	}
+	if z := new(inf.ExtDec).Abs(nz); z.String() != "0.00" {
+		t.Errorf("Abs(-0.00) got %v; expected 0.00", z)
+	}
+	if d := nz.Dec(); d.String() != "0.00" {
+		t.Errorf("Dec() of -0.00 got %v; expected 0.00", d)
+	}
+}
+
+func TestExtDecString(t *testing.T) {
+	for i, tt := range []struct {
+		in, out string
+		form    inf.Form
+	}{
+		{"1.20", "1.20", inf.Finite},
+		{"-0.5", "-0.5", inf.Finite},
+		{"-0.00", "-0.00", inf.Finite},
+		{"+0", "0", inf.Finite},
+		{"inf", "Infinity", inf.Infinite},
+		{"+Infinity", "Infinity", inf.Infinite},
+		{"-INF", "-Infinity", inf.Infinite},
+		{"NaN", "NaN", inf.NaN},
+		{"nan", "NaN", inf.NaN},
+	} {
+		x, ok := new(inf.ExtDec).SetString(tt.in)
+		if !ok || x.String() != tt.out || x.Form() != tt.form {
+			t.Errorf("#%d SetString(%q) got %v, %v; expected %s", i, tt.in, x, ok, tt.out)
+		}
+	}
+	for _, s := range []string{"", "-", "infinit", "NaN1", "1.2.3"} {
+		if x, ok := new(inf.ExtDec).SetString(s); ok {
+			t.Errorf("SetString(%q) got %v; expected failure", s, x)
+		}
+	}
+	var v struct{ A, B inf.ExtDec }
+	if err := json.Unmarshal([]byte(`{"A":"-Infinity","B":"2.5"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := json.Marshal(&v); err != nil || string(b) != `{"A":"-Infinity","B":"2.5"}` {
+		t.Errorf("json.Marshal got %s, %v", b, err)
+	}
+	if d := v.B.Dec(); d == nil || d.Cmp(inf.NewDec(25, 1)) != 0 {
+		t.Errorf("Dec() got %v; expected 2.5", d)
+	}
+	if d := v.A.Dec(); d != nil {
+		t.Errorf("Dec() of -Infinity got %v; expected <nil>", d)
+	}
+}
+
+func TestExtDecCmp(t *testing.T) {
+	xs := []*inf.ExtDec{extDec("1"), extDec("Infinity"), extDec("NaN"), extDec("-2"),
+		extDec("-Infinity"), extDec("0"), extDec("1.00")}
+	sort.SliceStable(xs, func(i, j int) bool { return xs[i].Cmp(xs[j]) < 0 })
+	exp := []string{"NaN", "-Infinity", "-2", "0", "1", "1.00", "Infinity"}
+	for i := range exp {
+		if xs[i].String() != exp[i] {
+			t.Errorf("sorted #%d got %v; expected %s", i, xs[i], exp[i])
+		}
+	}
+	if c := extDec("NaN").Cmp(extDec("nan")); c != 0 {
+		t.Errorf("Cmp(NaN, NaN) got %d; expected 0", c)
+	}
+	if s := extDec("-Infinity").Sign(); s != -1 {
+		t.Errorf("Sign(-Infinity) got %d; expected -1", s)
+	}
+	if z := new(inf.ExtDec).Neg(extDec("-Infinity")); !z.IsInf() || z.Sign() != 1 {
+		t.Errorf("Neg(-Infinity) got %v; expected Infinity", z)
+	}
+}
@@ -0,0 +1,147 @@
+package inf
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Mean returns the arithmetic mean of the values in xs, rounded using the
+// given Rounder to the specified scale. The sum is calculated exactly, and
+// the result is rounded only once.
+//
+// Mean returns nil if xs is empty, or if the rounder is RoundExact but the
+// result can not be expressed exactly at the specified scale.
+func Mean(xs []*Dec, s Scale, r Rounder) *Dec {
+	if len(xs) == 0 {
+		return nil
+	}
+	sum := new(Dec).Sum(xs...)
+	return new(Dec).QuoRound(sum, NewDec(int64(len(xs)), 0), s, r)
+}
+
+// Sum sets z to the exact sum of the values in xs, and returns z. The scale of
+// z is the greatest of the scales of the values (see AlignScales), or 0 if xs
+// is empty. The values are accumulated in a single pass; values with that
+// scale are added without rescaling.
+func (z *Dec) Sum(xs ...*Dec) *Dec {
+	s := AlignScales(xs...)
+	var sum, t big.Int
+	for _, x := range xs {
+		if d := checkScale(int64(s) - int64(x.Scale())); d > 0 {
+			sum.Add(&sum, t.Mul(x.UnscaledBig(), exp10(d)))
+		} else {
+			sum.Add(&sum, x.UnscaledBig())
+		}
+	}
+	z.UnscaledBig().Set(&sum)
+	return z.SetScale(s)
+}
+
+// GeometricMean returns the geometric mean of the values in xs (the nth root
+// of their product, where n is the number of values), rounded using the given
+// Rounder to the specified scale. The product is calculated exactly, and the
+// result is rounded only once.
+//
+// GeometricMean returns nil if xs is empty or contains negative values, or if
+// the rounder is RoundExact but the result can not be expressed exactly at
+// the specified scale.
+func GeometricMean(xs []*Dec, s Scale, r Rounder) *Dec {
+	if len(xs) == 0 {
+		return nil
+	}
+	prod := NewDec(1, 0)
+	for _, x := range xs {
+		if x.Sign() < 0 {
+			return nil
+		}
+		prod.Mul(prod, x)
+	}
+	return new(Dec).root(prod, len(xs), s, r)
+}
+
+// HarmonicMean returns the harmonic mean of the values in xs (the number of
+// values divided by the sum of their reciprocals), rounded using the given
+// Rounder to the specified scale. The sum of the reciprocals is calculated
+// exactly, and the result is rounded only once.
+//
+// HarmonicMean returns nil if xs is empty, if it contains zero, or if the sum
+// of the reciprocals is zero, or if the rounder is RoundExact but the result
+// can not be expressed exactly at the specified scale.
+func HarmonicMean(xs []*Dec, s Scale, r Rounder) *Dec {
+	if len(xs) == 0 {
+		return nil
+	}
+	one := NewDec(1, 0)
+	sum, inv := new(big.Rat), new(big.Rat)
+	for _, x := range xs {
+		if x.Sign() == 0 {
+			return nil
+		}
+		sum.Add(sum, inv.Inv(quoRat(x, one)))
+	}
+	if sum.Sign() == 0 {
+		return nil
+	}
+	// n / sum == n * sum.Denom() / sum.Num()
+	num := new(big.Int).Mul(big.NewInt(int64(len(xs))), sum.Denom())
+	return new(Dec).QuoRound(NewDecBig(num, 0), NewDecBig(sum.Num(), 0), s, r)
+}
+
+// Midpoint returns the exact value of (x+y)/2. The scale of the result is
+// the greater of the scales of x and y, plus one if the sum x+y is odd at
+// that scale.
+func Midpoint(x, y *Dec) *Dec {
+	z := new(Dec).Add(x, y)
+	u := z.UnscaledBig()
+	if u.Bit(0) == 0 {
+		u.Rsh(u, 1) // rounds toward -inf, but u is even
+		return z
+	}
+	u.Mul(u, bigInt[5])
+	return z.SetScale(checkScale(int64(z.Scale()) + 1))
+}
+
+// Lerp returns the linear interpolation a + t*(b-a) between a and b, rounded
+// using the given Rounder to the scale obtained from the given Scaler (which
+// is called with a and b). The interpolation is calculated exactly, and the
+// result is rounded only once. t is not restricted to [0, 1], so Lerp also
+// extrapolates.
+//
+// Lerp returns nil if the rounder is RoundExact but the result can not be
+// expressed exactly at the scale obtained.
+func Lerp(a, b, t *Dec, s Scaler, r Rounder) *Dec {
+	scl := s.Scale(a, b)
+	z := new(Dec).Sub(b, a)
+	z.Mul(z, t)
+	return z.Round(z.Add(z, a), scl, r)
+}
+
+// DotProduct returns the exact sum of the products of the corresponding
+// values in a and b. The scale of the result is the greatest of the scales of
+// the products (the sums of the scales of the factors), or 0 if a and b are
+// empty. The products are accumulated in a single pass, using temporary
+// storage that is allocated once.
+//
+// DotProduct returns an error if a and b have different lengths.
+func DotProduct(a, b []*Dec) (*Dec, error) {
+	if len(a) != len(b) {
+		return nil, fmt.Errorf("DotProduct: vectors with lengths %d and %d", len(a), len(b))
+	}
+	var s Scale
+	for i := range a {
+		if ps := checkScale(int64(a[i].Scale()) + int64(b[i].Scale())); i == 0 || ps > s {
+			s = ps
+		}
+	}
+	z := new(Dec).SetScale(s)
+	sum := z.UnscaledBig()
+	var p big.Int
+	for i := range a {
+		p.Mul(a[i].UnscaledBig(), b[i].UnscaledBig())
+		if d := checkScale(int64(s) - int64(a[i].Scale()) - int64(b[i].Scale())); d > 0 {
+			p.Mul(&p, exp10(d))
+		}
+		sum.Add(sum, &p)
+	}
+	return z, nil
+}
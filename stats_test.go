@@ -0,0 +1,143 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+var decMeansTests = []struct {
+	xs                      []string
+	s                       inf.Scale
+	r                       inf.Rounder
+	mean, geomean, harmmean string // empty if nil result expected
+}{
+	{nil, 2, inf.RoundHalfEven, "", "", ""},
+	{[]string{"5"}, 2, inf.RoundExact, "5.00", "5.00", "5.00"},
+	{[]string{"1", "4"}, 2, inf.RoundExact, "2.50", "2.00", "1.60"},
+	{[]string{"2", "8"}, 0, inf.RoundExact, "5", "4", ""},
+	{[]string{"1", "2", "4"}, 4, inf.RoundHalfEven, "2.3333", "2.0000", "1.7143"},
+	{[]string{"1.05", "1.10", "0.97"}, 6, inf.RoundHalfEven, "1.040000", "1.038607", "1.037201"},
+	{[]string{"1", "2"}, 6, inf.RoundDown, "1.500000", "1.414213", "1.333333"},
+	{[]string{"1", "2"}, 6, inf.RoundUp, "1.500000", "1.414214", "1.333334"},
+	{[]string{"0", "2"}, 2, inf.RoundHalfEven, "1.00", "0.00", ""},
+	{[]string{"-1", "2"}, 2, inf.RoundHalfEven, "0.50", "", "-4.00"},
+	{[]string{"-1", "1"}, 2, inf.RoundHalfEven, "0.00", "", ""},
+}
+
+func TestDecMeans(t *testing.T) {
+	check := func(i int, name string, z *inf.Dec, exp string) {
+		if exp == "" && z != nil || exp != "" && (z == nil || z.String() != exp) {
+			t.Errorf("#%d %s got %v; expected %q", i, name, z, exp)
+		}
+	}
+	for i, tt := range decMeansTests {
+		xs := make([]*inf.Dec, len(tt.xs))
+		for j, s := range tt.xs {
+			xs[j], _ = new(inf.Dec).SetString(s)
+		}
+		check(i, "Mean", inf.Mean(xs, tt.s, tt.r), tt.mean)
+		check(i, "GeometricMean", inf.GeometricMean(xs, tt.s, tt.r), tt.geomean)
+		check(i, "HarmonicMean", inf.HarmonicMean(xs, tt.s, tt.r), tt.harmmean)
+	}
+}
+
+func TestDecMidpoint(t *testing.T) {
+	for i, tt := range []struct {
+		x, y *inf.Dec
+		exp  string
+	}{
+		{inf.NewDec(1, 0), inf.NewDec(3, 0), "2"},
+		{inf.NewDec(1, 0), inf.NewDec(2, 0), "1.5"},
+		{inf.NewDec(-1, 0), inf.NewDec(-2, 0), "-1.5"},
+		{inf.NewDec(-3, 0), inf.NewDec(1, 0), "-1"},
+		{inf.NewDec(1, 2), inf.NewDec(2, 2), "0.015"},
+		{inf.NewDec(100, 2), inf.NewDec(2, 0), "1.50"},
+		{inf.NewDec(-1, 0), inf.NewDec(1, 0), "0"},
+		{inf.NewDec(1, -2), inf.NewDec(1, -3), "550"},
+	} {
+		if z := inf.Midpoint(tt.x, tt.y); z.String() != tt.exp {
+			t.Errorf("#%d Midpoint(%v, %v) got %v; expected %s", i, tt.x, tt.y, z, tt.exp)
+		}
+	}
+}
+
+func TestLerp(t *testing.T) {
+	for i, tt := range []struct {
+		a, b, t string
+		s       inf.Scale
+		r       inf.Rounder
+		exp     string // empty if nil is expected
+	}{
+		{"10.00", "20.00", "0.5", 2, inf.RoundExact, "15.00"},
+		{"10.00", "20.00", "0", 2, inf.RoundExact, "10.00"},
+		{"10.00", "20.00", "1", 2, inf.RoundExact, "20.00"},
+		{"10.00", "20.00", "1.5", 2, inf.RoundExact, "25.00"},
+		{"20", "10", "0.25", 1, inf.RoundExact, "17.5"},
+		{"1.00", "2.00", "0.333", 2, inf.RoundHalfEven, "1.33"},
+		{"1.00", "2.00", "0.333", 2, inf.RoundExact, ""},
+		{"-1", "1", "0.125", 2, inf.RoundHalfUp, "-0.75"},
+	} {
+		xs := decs(tt.a, tt.b, tt.t)
+		z := inf.Lerp(xs[0], xs[1], xs[2], inf.ScaleFixed(tt.s), tt.r)
+		if tt.exp == "" {
+			if z != nil {
+				t.Errorf("#%d Lerp(%v, %v, %v) got %v; expected nil", i, xs[0], xs[1], xs[2], z)
+			}
+		} else if z == nil || z.String() != tt.exp {
+			t.Errorf("#%d Lerp(%v, %v, %v) got %v; expected %s", i, xs[0], xs[1], xs[2], z, tt.exp)
+		}
+	}
+}
+
+func TestDecSum(t *testing.T) {
+	for i, tt := range []struct {
+		xs  []string
+		exp string
+	}{
+		{nil, "0"},
+		{[]string{"1.5"}, "1.5"},
+		{[]string{"1.5", "2.25", "-3"}, "0.75"},
+		{[]string{"0.1", "0.2", "-0.30"}, "0.00"},
+		{[]string{"100", "0.001"}, "100.001"},
+	} {
+		xs := decs(tt.xs...)
+		if z := new(inf.Dec).Sum(xs...); z.String() != tt.exp {
+			t.Errorf("#%d Sum%v got %v; expected %s", i, xs, z, tt.exp)
+		}
+		if len(xs) > 0 {
+			// aliased
+			z := xs[0]
+			if z.Sum(xs...); z.String() != tt.exp {
+				t.Errorf("#%d Sum%v into first argument got %v; expected %s", i, tt.xs, z, tt.exp)
+			}
+		}
+	}
+	xs := []*inf.Dec{inf.NewDec(12, -2), inf.NewDec(5, 1)}
+	if z := new(inf.Dec).Sum(xs...); z.Cmp(inf.NewDec(12005, 1)) != 0 || z.Scale() != 1 {
+		t.Errorf("Sum%v got %v (scale %d); expected 1200.5", xs, z, z.Scale())
+	}
+}
+
+func TestDotProduct(t *testing.T) {
+	for i, tt := range []struct {
+		a, b []string
+		exp  string // empty if an error is expected
+	}{
+		{nil, nil, "0"},
+		{[]string{"10", "2.5"}, []string{"1.25", "4"}, "22.50"},
+		{[]string{"100", "-3"}, []string{"19.99", "0.001"}, "1998.997"},
+		{[]string{"0.5"}, []string{"0.5"}, "0.25"},
+		{[]string{"1", "2"}, []string{"1"}, ""},
+	} {
+		a, b := decs(tt.a...), decs(tt.b...)
+		z, err := inf.DotProduct(a, b)
+		if tt.exp == "" {
+			if err == nil {
+				t.Errorf("#%d DotProduct(%v, %v) got %v; expected error", i, a, b, z)
+			}
+		} else if err != nil || z.String() != tt.exp {
+			t.Errorf("#%d DotProduct(%v, %v) got %v, %v; expected %s", i, a, b, z, err, tt.exp)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package inf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MarshalText implements the encoding.TextMarshaler interface; s is encoded
+// as a decimal integer.
+func (s Scale) MarshalText() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(s), 10), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *Scale) UnmarshalText(data []byte) error {
+	v, err := strconv.ParseInt(string(data), 10, 32)
+	if err != nil {
+		return fmt.Errorf("Scale.UnmarshalText: invalid scale %q", data)
+	}
+	*s = Scale(v)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface; s is encoded as a
+// JSON number (as it would be without the MarshalText method).
+func (s Scale) MarshalJSON() ([]byte, error) {
+	return s.MarshalText()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface; it accepts both
+// JSON numbers and strings containing a decimal integer.
+func (s *Scale) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return s.UnmarshalText(data)
+}
+
+// A RoundingMode is the name of one of the predefined Rounders, for use in
+// configuration structures loaded from JSON, YAML and similar formats. The
+// names are:
+//
+//	"exact"      RoundExact
+//	"down"       RoundDown
+//	"up"         RoundUp
+//	"floor"      RoundFloor
+//	"ceil"       RoundCeil
+//	"half-down"  RoundHalfDown
+//	"half-up"    RoundHalfUp
+//	"half-even"  RoundHalfEven
+//
+// "ceiling" is accepted as an alias of "ceil", and Rounders registered with
+// RegisterRounder are valid rounding modes as well. The empty RoundingMode
+// denotes no rounding mode (such as an unset field); its Rounder is nil.
+type RoundingMode string
+
+// The predefined rounding modes.
+const (
+	ModeExact    RoundingMode = "exact"
+	ModeDown     RoundingMode = "down"
+	ModeUp       RoundingMode = "up"
+	ModeFloor    RoundingMode = "floor"
+	ModeCeil     RoundingMode = "ceil"
+	ModeHalfDown RoundingMode = "half-down"
+	ModeHalfUp   RoundingMode = "half-up"
+	ModeHalfEven RoundingMode = "half-even"
+)
+
+// rounderModes maps rounding modes to the variables holding the Rounders,
+// which are only set on initialization, and to registered Rounders (see
+// RegisterRounder). It is guarded by roundersMu.
+var rounderModes = map[RoundingMode]*Rounder{
+	ModeExact:    &RoundExact,
+	ModeDown:     &RoundDown,
+	ModeUp:       &RoundUp,
+	ModeFloor:    &RoundFloor,
+	ModeCeil:     &RoundCeil,
+	ModeHalfDown: &RoundHalfDown,
+	ModeHalfUp:   &RoundHalfUp,
+	ModeHalfEven: &RoundHalfEven,
+	"ceiling":    &RoundCeil,
+}
+
+var roundersMu sync.RWMutex
+
+// modeAliases maps alternative names of rounding modes to their canonical
+// names.
+var modeAliases = map[RoundingMode]RoundingMode{
+	"ceiling": ModeCeil,
+}
+
+// normMode returns the canonical form of a rounding mode name: lower case,
+// with '-' in place of '_', and aliases replaced by the names they stand for.
+func normMode(name string) RoundingMode {
+	m := RoundingMode(strings.Replace(strings.ToLower(name), "_", "-", -1))
+	if c, ok := modeAliases[m]; ok {
+		return c
+	}
+	return m
+}
+
+func lookupRounder(m RoundingMode) (Rounder, bool) {
+	roundersMu.RLock()
+	defer roundersMu.RUnlock()
+	if r, ok := rounderModes[m]; ok {
+		return *r, true
+	}
+	return nil, false
+}
+
+// RegisterRounder makes r available under the given name to RounderByName and
+// as a RoundingMode. Names are matched as by RounderByName. RegisterRounder
+// panics if the name is empty, if r is nil, or if the name is already in use
+// (including the names of the predefined rounding modes).
+func RegisterRounder(name string, r Rounder) {
+	m := normMode(name)
+	if m == "" || r == nil {
+		panic("RegisterRounder: empty name or nil Rounder")
+	}
+	roundersMu.Lock()
+	defer roundersMu.Unlock()
+	if _, dup := rounderModes[m]; dup {
+		panic("RegisterRounder: called twice for " + string(m))
+	}
+	rounderModes[m] = &r
+}
+
+// RounderByName returns the Rounder with the given name: one of the names of
+// the predefined rounding modes (see RoundingMode), "ceiling" (an alias of
+// "ceil", as in the General Decimal Arithmetic specification), or a name
+// registered with RegisterRounder. Names are matched case-insensitively, and
+// '_' is accepted in place of '-' (as in "HALF_EVEN"). The result is false if
+// there is no such Rounder.
+func RounderByName(name string) (Rounder, bool) {
+	return lookupRounder(normMode(name))
+}
+
+// Rounder returns the Rounder named by m, or nil if m is empty or not a valid
+// rounding mode.
+func (m RoundingMode) Rounder() Rounder {
+	r, _ := lookupRounder(m)
+	return r
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. It returns an
+// error if m is neither empty nor a valid rounding mode.
+func (m RoundingMode) MarshalText() ([]byte, error) {
+	if _, ok := lookupRounder(m); !ok && m != "" {
+		return nil, fmt.Errorf("RoundingMode.MarshalText: invalid rounding mode %q", string(m))
+	}
+	return []byte(m), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Names are
+// matched case-insensitively, and '_' is accepted in place of '-' (as in
+// "HALF_EVEN"); m is set to the canonical name. Empty text is accepted and
+// sets m to the empty RoundingMode.
+func (m *RoundingMode) UnmarshalText(data []byte) error {
+	v := normMode(string(data))
+	if _, ok := lookupRounder(v); !ok && v != "" {
+		return fmt.Errorf("RoundingMode.UnmarshalText: invalid rounding mode %q", data)
+	}
+	*m = v
+	return nil
+}
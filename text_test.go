@@ -0,0 +1,142 @@
+package inf_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+type roundingConfig struct {
+	MaxScale inf.Scale
+	Rounding inf.RoundingMode
+}
+
+func TestScaleText(t *testing.T) {
+	for _, s := range []inf.Scale{0, 4, -3, 1<<31 - 1, -1 << 31} {
+		b, err := s.MarshalText()
+		var got inf.Scale
+		if err == nil {
+			err = got.UnmarshalText(b)
+		}
+		if err != nil || got != s {
+			t.Errorf("Scale %d text round trip got %d, %v", s, got, err)
+		}
+	}
+	for _, in := range []string{"", "1.5", "x", "2147483648"} {
+		var s inf.Scale
+		if err := s.UnmarshalText([]byte(in)); err == nil {
+			t.Errorf("Scale.UnmarshalText(%q) got %d; expected error", in, s)
+		}
+	}
+}
+
+func TestRoundingConfigJSON(t *testing.T) {
+	for i, tt := range []struct {
+		in    string
+		scale inf.Scale
+		mode  inf.RoundingMode
+		x     string // x rounded with the config; empty if mode is empty
+		err   bool
+	}{
+		{`{"MaxScale": 2, "Rounding": "half-even"}`, 2, inf.ModeHalfEven, "-1.24", false},
+		{`{"MaxScale": "1", "Rounding": "HALF_UP"}`, 1, inf.ModeHalfUp, "-1.2", false},
+		{`{"MaxScale": 0, "Rounding": "floor"}`, 0, inf.ModeFloor, "-2", false},
+		{`{"MaxScale": 1, "Rounding": "CEILING"}`, 1, inf.ModeCeil, "-1.2", false},
+		{`{"MaxScale": 3}`, 3, "", "", false},
+		{`{"MaxScale": 1.5}`, 0, "", "", true},
+		{`{"Rounding": "nearest"}`, 0, "", "", true},
+	} {
+		var c roundingConfig
+		err := json.Unmarshal([]byte(tt.in), &c)
+		if tt.err {
+			if err == nil {
+				t.Errorf("#%d Unmarshal(%s) got %+v; expected error", i, tt.in, c)
+			}
+			continue
+		}
+		if err != nil || c.MaxScale != tt.scale || c.Rounding != tt.mode {
+			t.Errorf("#%d Unmarshal(%s) got %+v, %v", i, tt.in, c, err)
+			continue
+		}
+		if r := c.Rounding.Rounder(); tt.x == "" {
+			if r != nil {
+				t.Errorf("#%d %q.Rounder() got non-nil", i, c.Rounding)
+			}
+		} else if z := new(inf.Dec).Round(inf.NewDec(-12350, 4), c.MaxScale, r); z.String() != tt.x {
+			t.Errorf("#%d rounding -1.2350 with %+v got %v; expected %s", i, c, z, tt.x)
+		}
+		b, err := json.Marshal(c)
+		var c2 roundingConfig
+		if err == nil {
+			err = json.Unmarshal(b, &c2)
+		}
+		if err != nil || c2 != c {
+			t.Errorf("#%d round trip of %+v via %s got %+v, %v", i, c, b, c2, err)
+		}
+	}
+	b, err := json.Marshal(roundingConfig{4, inf.ModeHalfEven})
+	if exp := `{"MaxScale":4,"Rounding":"half-even"}`; err != nil || string(b) != exp {
+		t.Errorf("Marshal got %s, %v; expected %s", b, err, exp)
+	}
+	if _, err := json.Marshal(roundingConfig{Rounding: "nearest"}); err == nil {
+		t.Errorf("Marshal with invalid rounding mode succeeded")
+	}
+}
+
+func TestRounderByName(t *testing.T) {
+	x := inf.NewDec(-25, 1)
+	for _, tt := range []struct {
+		name string
+		exp  string // empty if not found
+	}{
+		{"half-even", "-2"},
+		{"HALF_UP", "-3"},
+		{"Floor", "-3"},
+		{"ceiling", "-2"},
+		{"ceil", "-2"},
+		{"down", "-2"},
+		{"nearest", ""},
+		{"", ""},
+	} {
+		r, ok := inf.RounderByName(tt.name)
+		if ok != (tt.exp != "") {
+			t.Errorf("RounderByName(%q) got ok %v", tt.name, ok)
+			continue
+		}
+		if ok {
+			if z := new(inf.Dec).Round(x, 0, r); z.String() != tt.exp {
+				t.Errorf("Round(%v) with %q got %v; expected %s", x, tt.name, z, tt.exp)
+			}
+		}
+	}
+
+	// round half towards +infinity (registered once per process, as with
+	// -count)
+	if _, ok := inf.RounderByName("test-half-ceil"); !ok {
+		inf.RegisterRounder("Test_Half_Ceil", inf.RoundHalfWith(func(quoIsEven bool, sign int) bool {
+			return sign > 0
+		}))
+	}
+	r, ok := inf.RounderByName("test-half-ceil")
+	if !ok {
+		t.Fatal("registered rounder not found")
+	}
+	if z := new(inf.Dec).Round(x, 0, r); z.String() != "-2" {
+		t.Errorf("Round(%v) with registered rounder got %v; expected -2", x, z)
+	}
+	var m inf.RoundingMode
+	if err := m.UnmarshalText([]byte("TEST_HALF_CEIL")); err != nil || m.Rounder() == nil {
+		t.Errorf("UnmarshalText of registered name got %q, %v", m, err)
+	}
+	for _, name := range []string{"test-half-ceil", "HALF-EVEN", ""} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("RegisterRounder(%q) did not panic", name)
+				}
+			}()
+			inf.RegisterRounder(name, inf.RoundDown)
+		}()
+	}
+}
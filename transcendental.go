@@ -0,0 +1,319 @@
+package inf
+
+import (
+	"math/big"
+)
+
+// Transcendental functions are calculated in fixed point, with unscaled
+// values at a working scale w greater than the requested scale, along with a
+// bound on the error of the result in units of 10**-w. If the result can not
+// be rounded unambiguously within that bound, the calculation is repeated
+// with more guard digits. As the exact results (for arguments other than
+// trivial ones) are irrational, this always terminates, and the results are
+// correctly rounded.
+
+// fixed returns the value of x at the scale w, truncated towards zero.
+func fixed(x *Dec, w Scale) *big.Int {
+	if d := checkScale(int64(w) - int64(x.Scale())); d >= 0 {
+		return new(big.Int).Mul(x.UnscaledBig(), exp10(d))
+	}
+	return new(big.Int).Quo(x.UnscaledBig(), exp10(checkScale(int64(x.Scale())-int64(w))))
+}
+
+// magnitude returns an estimate of the number of decimal digits in the
+// integer part of e**x, for choosing the initial working scale.
+func magnitude(x *Dec) int {
+	f, _ := quoRat(x, NewDec(1, 0)).Float64()
+	if f <= 0 {
+		return 0
+	}
+	if f > 1e9 {
+		f = 1e9
+	}
+	return int(f*0.4343) + 1
+}
+
+// fracClass returns v (at a scale d digits greater than the target scale)
+// truncated towards zero to the target scale, and the position of the
+// discarded fraction: fracBelowHalf or fracAboveHalf, or -1 if the
+// fraction is zero or exactly one half.
+func fracClass(v *big.Int, d Scale) (*big.Int, int) {
+	q, rem := new(big.Int).QuoRem(v, exp10(d), new(big.Int))
+	rem.Lsh(rem.Abs(rem), 1)
+	switch c := rem.Cmp(exp10(d)); {
+	case rem.Sign() == 0 || c == 0:
+		return q, -1
+	case c < 0:
+		return q, fracBelowHalf
+	}
+	return q, fracAboveHalf
+}
+
+// roundApprox rounds a value known to be (strictly) within e units of the
+// approximation a at the scale w to the scale s < w using r. It returns
+// false if the rounding can not be determined, that is, if the interval
+// a±e contains zero or a value that is exact or halfway at the scale s, or
+// if e is too large for the error bound to be reliable (as error bounds are
+// calculated to first order). Otherwise it returns the rounded result, which
+// is nil if r is RoundExact.
+func roundApprox(a *big.Int, w Scale, e *big.Int, s Scale, r Rounder) (*Dec, bool) {
+	if e.Cmp(exp10(w/2)) >= 0 {
+		return nil, false
+	}
+	lo, hi := new(big.Int).Sub(a, e), new(big.Int).Add(a, e)
+	if lo.Sign() != hi.Sign() || lo.Sign() == 0 {
+		return nil, false
+	}
+	qlo, clo := fracClass(lo, w-s)
+	qhi, chi := fracClass(hi, w-s)
+	if clo < 0 || clo != chi || qlo.Cmp(qhi) != 0 {
+		return nil, false
+	}
+	return new(Dec).roundFrac(NewDecBig(qlo, s), a.Sign() < 0, clo, r), true
+}
+
+// workScale returns the working scale for the requested scale s and the
+// number of guard digits g.
+func workScale(s Scale, g int) Scale {
+	if s < 0 {
+		s = 0
+	}
+	return s + Scale(g)
+}
+
+// approx sets z to the value approximated by f, rounded using the given
+// Rounder to the specified scale, and returns z. f returns an approximation
+// at the working scale w and a bound of its error (see roundApprox); it is
+// called with g guard digits, doubling them until the result can be rounded.
+// approx returns nil if the rounder is RoundExact.
+func (z *Dec) approx(s Scale, r Rounder, g int, f func(w Scale) (*big.Int, *big.Int)) *Dec {
+	for ; ; g *= 2 {
+		w := workScale(s, g)
+		a, e := f(w)
+		if zz, ok := roundApprox(a, w, e, s, r); ok {
+			if zz == nil {
+				return nil
+			}
+			return z.Set(zz)
+		}
+	}
+}
+
+// negligible reports whether e**-|x| is less than 10**-(s+2) (or 10**-2 for
+// negative s) by a safe margin, using 3 > ln(10), so that results that only
+// differ from 0 or ±1 by e**-|x| can be rounded without calculating it.
+// A factor k is applied to x, as for e**-2|x|.
+func negligible(x *Dec, k int64, s Scale) bool {
+	if s < 0 {
+		s = 0
+	}
+	ax := new(Dec).Abs(x)
+	return ax.Mul(ax, NewDec(k, 0)).Cmp(NewDec(3*(int64(s)+2)+1, 0)) > 0
+}
+
+// roundNearZero sets z to d for some 0 < d < 10**-(s+2), rounded using the
+// given Rounder to the scale s, and returns z.
+func (z *Dec) roundNearZero(s Scale, r Rounder) *Dec {
+	return z.roundFrac(NewDec(0, s), false, fracBelowHalf, r)
+}
+
+// roundNearOne sets z to 1-d (or -(1-d) if neg is true) for some
+// 0 < d < 10**-(s+2), rounded using the given Rounder to the scale s, and
+// returns z.
+func (z *Dec) roundNearOne(neg bool, s Scale, r Rounder) *Dec {
+	if s < 0 {
+		// |1-d| < 10**-s / 2
+		return z.roundFrac(NewDec(0, s), neg, fracBelowHalf, r)
+	}
+	// 1-d truncated is 0.99...9, with a discarded fraction above one half
+	q := NewDecBig(new(big.Int).Sub(exp10(s), bigInt[1]), s)
+	if neg {
+		q.Neg(q)
+	}
+	return z.roundFrac(q, neg, fracAboveHalf, r)
+}
+
+// expm1Fixed returns an approximation of e**x - 1 at the scale w, and a bound
+// of its error, where x is given at the scale w (with an error of up to one
+// unit).
+func expm1Fixed(x *big.Int, w Scale) (*big.Int, *big.Int) {
+	one := exp10(w)
+	// reduce x to |y| <= 1/16, so that e**x - 1 is obtained from e**y - 1 by
+	// doubling k times, using e**2y - 1 = (e**y - 1) * (e**y - 1 + 2)
+	y, k := new(big.Int).Set(x), 0
+	limit := new(big.Int).Quo(one, big.NewInt(16))
+	for new(big.Int).Abs(y).Cmp(limit) > 0 {
+		y.Quo(y, bigInt[2])
+		k++
+	}
+	// Taylor series; each term has an error of up to 2 units
+	m, t := new(big.Int).Set(y), new(big.Int).Set(y)
+	n := int64(1)
+	for t.Sign() != 0 {
+		n++
+		t.Mul(t, y)
+		t.Quo(t, one)
+		t.Quo(t, big.NewInt(n))
+		m.Add(m, t)
+	}
+	e := big.NewInt(2*n + 6)
+	c, m2 := new(big.Int), new(big.Int)
+	for i := 0; i < k; i++ {
+		// error bound: e * (2|m|+2) + e**2 + 1 (truncation)
+		c.Quo(c.Abs(m), one)
+		c.Add(c, bigInt[1])
+		c.Lsh(c, 1)
+		c.Add(c, bigInt[3])
+		e.Mul(e, c)
+		e.Add(e, bigInt[2])
+		m2.Lsh(one, 1)
+		m2.Add(m2, m)
+		m.Mul(m, m2)
+		m.Quo(m, one)
+	}
+	return m, e
+}
+
+// ln1pFixed returns an approximation of ln(1+x) at the scale w, and a bound
+// of its error, where x > -1 is given at the scale w (with an error of up to
+// one unit).
+func ln1pFixed(x *big.Int, w Scale) (*big.Int, *big.Int) {
+	one := exp10(w)
+	a := new(big.Int).Add(one, x)
+	// bound of 1/a (in units of one), by which the errors of a are amplified
+	inv := new(big.Int).Quo(one, a)
+	inv.Add(inv, bigInt[1])
+	// reduce a to |a-1| <= 1/16, using ln(a) = 2*ln(sqrt(a))
+	k := 0
+	limit := new(big.Int).Quo(one, big.NewInt(16))
+	y := new(big.Int).Sub(a, one)
+	for new(big.Int).Abs(y).Cmp(limit) > 0 {
+		a.Sqrt(a.Mul(a, one))
+		k++
+		if i := new(big.Int).Quo(one, a); i.Cmp(inv) >= 0 {
+			inv.Add(i, bigInt[1])
+		}
+		y.Sub(a, one)
+	}
+	// ln(1+y) = 2*atanh(u) with u = y/(2+y), by its Taylor series
+	u := new(big.Int).Mul(y, one)
+	u.Quo(u, new(big.Int).Add(y, new(big.Int).Lsh(one, 1)))
+	u2 := new(big.Int).Mul(u, u)
+	u2.Quo(u2, one)
+	sum, p, t := new(big.Int).Set(u), new(big.Int).Set(u), new(big.Int)
+	n := int64(1)
+	for {
+		p.Mul(p, u2)
+		p.Quo(p, one)
+		t.Quo(p, big.NewInt(2*n+1))
+		if t.Sign() == 0 {
+			break
+		}
+		sum.Add(sum, t)
+		n++
+	}
+	l := sum.Lsh(sum, uint(k+1))
+	e := big.NewInt(6*n + 10)
+	e.Lsh(e, uint(k))
+	e.Add(e, inv.Lsh(inv, uint(k+1)))
+	return l, e
+}
+
+// Expm1 sets z to e**x - 1, rounded using the given Rounder to the specified
+// scale, and returns z. The result is correctly rounded, and is accurate
+// even for x close to zero (where calculating e**x and then subtracting 1
+// would lose significant digits).
+//
+// As e**x - 1 is irrational for x other than zero, Expm1 returns nil if the
+// rounder is RoundExact and x is not zero.
+func (z *Dec) Expm1(x *Dec, s Scale, r Rounder) *Dec {
+	if x.Sign() == 0 {
+		return z.Round(x, s, r)
+	}
+	if x.Sign() < 0 && negligible(x, 1, s) {
+		// e**x is too small to affect the rounded result
+		return z.roundNearOne(true, s, r)
+	}
+	return z.approx(s, r, 10+magnitude(x), func(w Scale) (*big.Int, *big.Int) {
+		return expm1Fixed(fixed(x, w), w)
+	})
+}
+
+// Ln1p sets z to the natural logarithm of 1+x, rounded using the given
+// Rounder to the specified scale, and returns z. The result is correctly
+// rounded, and is accurate even for x close to zero (where calculating 1+x
+// and then its logarithm would lose significant digits).
+//
+// Ln1p returns nil if x <= -1. As ln(1+x) is irrational for x other than
+// zero, Ln1p also returns nil if the rounder is RoundExact and x is not zero.
+func (z *Dec) Ln1p(x *Dec, s Scale, r Rounder) *Dec {
+	if x.Cmp(NewDec(-1, 0)) <= 0 {
+		return nil
+	}
+	if x.Sign() == 0 {
+		return z.Round(x, s, r)
+	}
+	return z.approx(s, r, 10, func(w Scale) (*big.Int, *big.Int) {
+		return ln1pFixed(fixed(x, w), w)
+	})
+}
+
+// Exp sets z to e**x, rounded using the given Rounder to the specified scale,
+// and returns z. The result is correctly rounded.
+//
+// As e**x is irrational for x other than zero, Exp returns nil if the rounder
+// is RoundExact and x is not zero.
+func (z *Dec) Exp(x *Dec, s Scale, r Rounder) *Dec {
+	if x.Sign() == 0 {
+		return z.Round(NewDec(1, 0), s, r)
+	}
+	if x.Sign() < 0 && negligible(x, 1, s) {
+		return z.roundNearZero(s, r)
+	}
+	return z.approx(s, r, 10+magnitude(x), func(w Scale) (*big.Int, *big.Int) {
+		m, e := expm1Fixed(fixed(x, w), w)
+		return m.Add(m, exp10(w)), e
+	})
+}
+
+// Ln sets z to the natural logarithm of x, rounded using the given Rounder to
+// the specified scale, and returns z. The result is correctly rounded.
+//
+// Ln returns nil if x <= 0. As ln(x) is irrational for x other than one, Ln
+// also returns nil if the rounder is RoundExact and x is not one.
+func (z *Dec) Ln(x *Dec, s Scale, r Rounder) *Dec {
+	if x.Sign() <= 0 {
+		return nil
+	}
+	return z.Ln1p(new(Dec).Sub(x, NewDec(1, 0)), s, r)
+}
+
+// Log10 sets z to the base 10 logarithm of x, rounded using the given Rounder
+// to the specified scale, and returns z. The result is correctly rounded, and
+// is exact for powers of ten.
+//
+// Log10 returns nil if x <= 0. As log10(x) is irrational for x other than
+// powers of ten, Log10 also returns nil if the rounder is RoundExact and x is
+// not a power of ten.
+func (z *Dec) Log10(x *Dec, s Scale, r Rounder) *Dec {
+	if x.Sign() <= 0 {
+		return nil
+	}
+	if u, us := x.reduced(); u.Cmp(bigInt[1]) == 0 {
+		return z.Round(NewDec(-int64(us), 0), s, r)
+	}
+	xm1 := new(Dec).Sub(x, NewDec(1, 0))
+	return z.approx(s, r, 10, func(w Scale) (*big.Int, *big.Int) {
+		one := exp10(w)
+		l, e := ln1pFixed(fixed(xm1, w), w)
+		l10, e10 := ln1pFixed(new(big.Int).Mul(one, bigInt[9]), w)
+		q := new(big.Int).Mul(l, one)
+		q.Quo(q, l10)
+		// error bound (as ln(10) > 2): e + |ln(x)|*e10 + 2
+		t := new(big.Int).Abs(l)
+		t.Quo(t, one)
+		t.Add(t, bigInt[1])
+		t.Mul(t, e10)
+		return q, t.Add(t, e.Add(e, bigInt[2]))
+	})
+}
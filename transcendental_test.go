@@ -0,0 +1,198 @@
+package inf_test
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/inf.v0"
+)
+
+var decExpm1Tests = []struct {
+	x                string
+	s                inf.Scale
+	halfEven, up, dn string
+}{
+	{"0", 2, "0.00", "0.00", "0.00"},
+	{"0.000137", 20, "0.00013700938492857351", "0.00013700938492857352", "0.00013700938492857351"},
+	{"1", 30, "1.718281828459045235360287471353", "1.718281828459045235360287471353", "1.718281828459045235360287471352"},
+	{"-1", 10, "-0.6321205588", "-0.6321205589", "-0.6321205588"},
+	{"10", 5, "22025.46579", "22025.46580", "22025.46579"},
+	{"-20", 12, "-0.999999997939", "-0.999999997939", "-0.999999997938"},
+	{"0.5", 0, "1", "1", "0"},
+	{"0.000000000000000000000000000001", 10, "0.0000000000", "0.0000000001", "0.0000000000"},
+	{"-0.000000000000000000000000000001", 10, "0.0000000000", "-0.0000000001", "0.0000000000"},
+	{"123.456", 3, "413294435277809344957685441227343146614594393746575437.725",
+		"413294435277809344957685441227343146614594393746575437.726",
+		"413294435277809344957685441227343146614594393746575437.725"},
+}
+
+var decLn1pTests = []struct {
+	x                string
+	s                inf.Scale
+	halfEven, up, dn string
+}{
+	{"0", 2, "0.00", "0.00", "0.00"},
+	{"0.000137", 20, "0.00013699061635702961", "0.00013699061635702961", "0.00013699061635702960"},
+	{"1", 30, "0.693147180559945309417232121458", "0.693147180559945309417232121459", "0.693147180559945309417232121458"},
+	{"-0.5", 10, "-0.6931471806", "-0.6931471806", "-0.6931471805"},
+	{"1000000", 8, "13.81551156", "13.81551156", "13.81551155"},
+	{"-0.999999", 6, "-13.815511", "-13.815511", "-13.815510"},
+	{"0.000000000000000000000000000001", 10, "0.0000000000", "0.0000000001", "0.0000000000"},
+	{"-0.000000000000000000000000000001", 10, "0.0000000000", "-0.0000000001", "0.0000000000"},
+	{"0.0001", 0, "0", "1", "0"},
+}
+
+var decExpTests = []struct {
+	x                string
+	s                inf.Scale
+	halfEven, up, dn string
+}{
+	{"0", 2, "1.00", "1.00", "1.00"},
+	{"1", 20, "2.71828182845904523536", "2.71828182845904523537", "2.71828182845904523536"},
+	{"-2.5", 15, "0.082084998623899", "0.082084998623899", "0.082084998623898"},
+	{"100", 3, "26881171418161354484126255515800135873611118.774",
+		"26881171418161354484126255515800135873611118.774",
+		"26881171418161354484126255515800135873611118.773"},
+	{"0.000001", 12, "1.000001000001", "1.000001000001", "1.000001000000"},
+	{"-50", 25, "0.0000000000000000000001929", "0.0000000000000000000001929", "0.0000000000000000000001928"},
+}
+
+var decLnTests = []struct {
+	x                string
+	s                inf.Scale
+	halfEven, up, dn string
+}{
+	{"1", 2, "0.00", "0.00", "0.00"},
+	{"2", 20, "0.69314718055994530942", "0.69314718055994530942", "0.69314718055994530941"},
+	{"0.001", 10, "-6.9077552790", "-6.9077552790", "-6.9077552789"},
+	{"100000000000000000000000000000000000000000000000000", 8, "115.12925465", "115.12925465", "115.12925464"},
+	{"1.000001", 15, "0.000000999999500", "0.000000999999501", "0.000000999999500"},
+}
+
+var decLog10Tests = []struct {
+	x                string
+	s                inf.Scale
+	halfEven, up, dn string
+}{
+	{"1", 2, "0.00", "0.00", "0.00"},
+	{"1000", 0, "3", "3", "3"},
+	{"0.0100", 1, "-2.0", "-2.0", "-2.0"},
+	{"2", 20, "0.30102999566398119521", "0.30102999566398119522", "0.30102999566398119521"},
+	{"123.45", 12, "2.091491094268", "2.091491094268", "2.091491094267"},
+	{"0.5", 10, "-0.3010299957", "-0.3010299957", "-0.3010299956"},
+	{"99999", 9, "4.999995657", "4.999995658", "4.999995657"},
+}
+
+func TestDecExpm1(t *testing.T) {
+	for i, tt := range decExpm1Tests {
+		x := decs(tt.x)[0]
+		for j, r := range []inf.Rounder{inf.RoundHalfEven, inf.RoundUp, inf.RoundDown} {
+			exp := []string{tt.halfEven, tt.up, tt.dn}[j]
+			if z := new(inf.Dec).Expm1(x, tt.s, r); z == nil || z.String() != exp {
+				t.Errorf("#%d/%d Expm1(%v, %d) got %v; expected %s", i, j, x, tt.s, z, exp)
+			}
+		}
+	}
+	if z := new(inf.Dec).Expm1(inf.NewDec(1, 0), 5, inf.RoundExact); z != nil {
+		t.Errorf("Expm1(1) with RoundExact got %v; expected nil", z)
+	}
+}
+
+func TestDecExpLargeNegative(t *testing.T) {
+	start := time.Now()
+	for _, tt := range []struct {
+		x                  string
+		s                  inf.Scale
+		expm1Dn, expm1Even string
+		expDn, expUp       string
+	}{
+		{"-12", 2, "-0.99", "-1.00", "0.00", "0.01"},
+		{"-17", 2, "-0.99", "-1.00", "0.00", "0.01"},
+		{"-20000", 2, "-0.99", "-1.00", "0.00", "0.01"},
+		{"-100000", 2, "-0.99", "-1.00", "0.00", "0.01"},
+		{"-100000", 0, "0", "-1", "0", "1"},
+	} {
+		x := decs(tt.x)[0]
+		if z := new(inf.Dec).Expm1(x, tt.s, inf.RoundDown); z.String() != tt.expm1Dn {
+			t.Errorf("Expm1(%v, %d) with RoundDown got %v; expected %s", x, tt.s, z, tt.expm1Dn)
+		}
+		if z := new(inf.Dec).Expm1(x, tt.s, inf.RoundHalfEven); z.String() != tt.expm1Even {
+			t.Errorf("Expm1(%v, %d) with RoundHalfEven got %v; expected %s", x, tt.s, z, tt.expm1Even)
+		}
+		if z := new(inf.Dec).Exp(x, tt.s, inf.RoundDown); z.String() != tt.expDn {
+			t.Errorf("Exp(%v, %d) with RoundDown got %v; expected %s", x, tt.s, z, tt.expDn)
+		}
+		if z := new(inf.Dec).Exp(x, tt.s, inf.RoundUp); z.String() != tt.expUp {
+			t.Errorf("Exp(%v, %d) with RoundUp got %v; expected %s", x, tt.s, z, tt.expUp)
+		}
+		if z := new(inf.Dec).Expm1(x, tt.s, inf.RoundExact); z != nil {
+			t.Errorf("Expm1(%v, %d) with RoundExact got %v; expected nil", x, tt.s, z)
+		}
+	}
+	if z := new(inf.Dec).Expm1(decs("-100000")[0], -1, inf.RoundHalfEven); z.Sign() != 0 || z.Scale() != -1 {
+		t.Errorf("Expm1(-100000, -1) with RoundHalfEven got %v; expected 0 with scale -1", z)
+	}
+	if d := time.Since(start); d > 2*time.Second {
+		t.Errorf("Expm1 and Exp of large negative values took %v", d)
+	}
+}
+
+func TestDecLn1p(t *testing.T) {
+	for i, tt := range decLn1pTests {
+		x := decs(tt.x)[0]
+		for j, r := range []inf.Rounder{inf.RoundHalfEven, inf.RoundUp, inf.RoundDown} {
+			exp := []string{tt.halfEven, tt.up, tt.dn}[j]
+			if z := new(inf.Dec).Ln1p(x, tt.s, r); z == nil || z.String() != exp {
+				t.Errorf("#%d/%d Ln1p(%v, %d) got %v; expected %s", i, j, x, tt.s, z, exp)
+			}
+		}
+	}
+	for _, x := range []*inf.Dec{inf.NewDec(-1, 0), inf.NewDec(-2, 0)} {
+		if z := new(inf.Dec).Ln1p(x, 5, inf.RoundHalfEven); z != nil {
+			t.Errorf("Ln1p(%v) got %v; expected nil", x, z)
+		}
+	}
+	if z := new(inf.Dec).Ln1p(inf.NewDec(1, 0), 5, inf.RoundExact); z != nil {
+		t.Errorf("Ln1p(1) with RoundExact got %v; expected nil", z)
+	}
+}
+
+func TestDecExpLnLog10(t *testing.T) {
+	for _, f := range []struct {
+		name  string
+		tests []struct {
+			x                string
+			s                inf.Scale
+			halfEven, up, dn string
+		}
+		fn func(z, x *inf.Dec, s inf.Scale, r inf.Rounder) *inf.Dec
+	}{
+		{"Exp", decExpTests, (*inf.Dec).Exp},
+		{"Ln", decLnTests, (*inf.Dec).Ln},
+		{"Log10", decLog10Tests, (*inf.Dec).Log10},
+	} {
+		for i, tt := range f.tests {
+			x := decs(tt.x)[0]
+			for j, r := range []inf.Rounder{inf.RoundHalfEven, inf.RoundUp, inf.RoundDown} {
+				exp := []string{tt.halfEven, tt.up, tt.dn}[j]
+				if z := f.fn(new(inf.Dec), x, tt.s, r); z == nil || z.String() != exp {
+					t.Errorf("#%d/%d %s(%v, %d) got %v; expected %s", i, j, f.name, x, tt.s, z, exp)
+				}
+			}
+		}
+	}
+	for _, x := range []*inf.Dec{inf.NewDec(0, 0), inf.NewDec(-2, 0)} {
+		if z := new(inf.Dec).Ln(x, 5, inf.RoundHalfEven); z != nil {
+			t.Errorf("Ln(%v) got %v; expected nil", x, z)
+		}
+		if z := new(inf.Dec).Log10(x, 5, inf.RoundHalfEven); z != nil {
+			t.Errorf("Log10(%v) got %v; expected nil", x, z)
+		}
+	}
+	if z := new(inf.Dec).Exp(inf.NewDec(1, 0), 5, inf.RoundExact); z != nil {
+		t.Errorf("Exp(1) with RoundExact got %v; expected nil", z)
+	}
+	if z := new(inf.Dec).Log10(inf.NewDec(2, 0), 5, inf.RoundExact); z != nil {
+		t.Errorf("Log10(2) with RoundExact got %v; expected nil", z)
+	}
+}
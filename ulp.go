@@ -0,0 +1,22 @@
+package inf
+
+// Ulp returns a new Dec set to one unit in the last place of x at its
+// current scale; that is, 10**-x.Scale(), with the scale of x (such as 0.01
+// for 1.23 and 1.00, and 100 for 12 with scale -2).
+func (x *Dec) Ulp() *Dec {
+	return NewDec(1, x.Scale())
+}
+
+// NextPlus sets z to the value following x at the scale of x (x plus one ulp)
+// and returns z.
+func (z *Dec) NextPlus(x *Dec) *Dec {
+	z.UnscaledBig().Add(x.UnscaledBig(), bigInt[1])
+	return z.SetScale(x.Scale())
+}
+
+// NextMinus sets z to the value preceding x at the scale of x (x minus one
+// ulp) and returns z.
+func (z *Dec) NextMinus(x *Dec) *Dec {
+	z.UnscaledBig().Sub(x.UnscaledBig(), bigInt[1])
+	return z.SetScale(x.Scale())
+}
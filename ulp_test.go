@@ -0,0 +1,31 @@
+package inf_test
+
+import (
+	"testing"
+
+	"gopkg.in/inf.v0"
+)
+
+func TestDecUlp(t *testing.T) {
+	for i, tt := range []struct {
+		x                *inf.Dec
+		ulp, plus, minus string
+	}{
+		{inf.NewDec(123, 2), "0.01", "1.24", "1.22"},
+		{inf.NewDec(100, 2), "0.01", "1.01", "0.99"},
+		{inf.NewDec(0, 1), "0.1", "0.1", "-0.1"},
+		{inf.NewDec(-1, 0), "1", "0", "-2"},
+		{inf.NewDec(12, -2), "100", "1300", "1100"},
+	} {
+		if u := tt.x.Ulp(); u.String() != tt.ulp || u.Scale() != tt.x.Scale() {
+			t.Errorf("#%d %v.Ulp() got %v (scale %d); expected %s", i, tt.x, u, u.Scale(), tt.ulp)
+		}
+		x := new(inf.Dec).Set(tt.x)
+		if z := new(inf.Dec).NextPlus(x); z.String() != tt.plus || z.Scale() != tt.x.Scale() {
+			t.Errorf("#%d NextPlus(%v) got %v (scale %d); expected %s", i, x, z, z.Scale(), tt.plus)
+		}
+		if z := x.NextMinus(x); z.String() != tt.minus || z.Scale() != tt.x.Scale() {
+			t.Errorf("#%d NextMinus(%v) got %v (scale %d); expected %s", i, tt.x, z, z.Scale(), tt.minus)
+		}
+	}
+}